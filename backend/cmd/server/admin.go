@@ -0,0 +1,320 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+
+	"x-cells/backend/internal/game"
+)
+
+// adminHandler пропускает запрос только с токеном администратора в заголовке X-Admin-Token.
+// Если токен не задан, разрешены только запросы с локального адреса.
+func adminHandler(token string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if token != "" {
+			// Сравнение за постоянное время, чтобы токен нельзя было подобрать по задержке ответа
+			if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Admin-Token")), []byte(token)) != 1 {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+		} else if !isLoopback(r.RemoteAddr) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func isLoopback(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Printf("[Admin] Ошибка записи ответа: %v", err)
+	}
+}
+
+// tickerStatus состояние игрового цикла для ответов админских эндпоинтов
+type tickerStatus struct {
+	Paused bool   `json:"paused"`
+	Tick   uint64 `json:"tick"`
+}
+
+// registerTickerAdmin регистрирует эндпоинты управления игровым циклом:
+//
+//	POST /api/admin/ticker/pause         - поставить игру на паузу
+//	POST /api/admin/ticker/resume        - продолжить
+//	POST /api/admin/ticker/step?n=       - выполнить n тиков на паузе (по умолчанию 1)
+//	GET  /api/admin/ticker/status        - состояние и статистика цикла
+//	GET  /api/admin/ticker/history?tick= - снимок игроков и еды в конце тика (по умолчанию последнего)
+func registerTickerAdmin(gameTicker *game.GameTicker, token string) {
+	http.HandleFunc("/api/admin/ticker/pause", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		gameTicker.Pause()
+		writeJSON(w, http.StatusOK, tickerStatus{Paused: gameTicker.IsPaused(), Tick: gameTicker.GetTickCount()})
+	}))
+
+	http.HandleFunc("/api/admin/ticker/resume", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		gameTicker.Resume()
+		writeJSON(w, http.StatusOK, tickerStatus{Paused: gameTicker.IsPaused(), Tick: gameTicker.GetTickCount()})
+	}))
+
+	http.HandleFunc("/api/admin/ticker/step", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		n := 1
+		if raw := r.URL.Query().Get("n"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil {
+				http.Error(w, "Invalid n", http.StatusBadRequest)
+				return
+			}
+			n = parsed
+		}
+
+		tick, err := gameTicker.Step(n)
+		switch {
+		case errors.Is(err, game.ErrNotPaused):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			writeJSON(w, http.StatusOK, tickerStatus{Paused: true, Tick: tick})
+		}
+	}))
+
+	http.HandleFunc("/api/admin/ticker/status", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, http.StatusOK, gameTicker.GetStats())
+	}))
+
+	http.HandleFunc("/api/admin/ticker/history", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		tick := gameTicker.GetTickCount()
+		if raw := r.URL.Query().Get("tick"); raw != "" {
+			parsed, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid tick", http.StatusBadRequest)
+				return
+			}
+			tick = parsed
+		}
+
+		snapshot, ok := gameTicker.Rewind(tick)
+		if !ok {
+			http.Error(w, "History is empty", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, snapshot)
+	}))
+}
+
+// registerSystemsAdmin регистрирует эндпоинты управления системами игрового цикла:
+//
+//	GET  /api/admin/systems                        - расписание и метрики систем
+//	POST /api/admin/systems/enable?name=           - включить систему
+//	POST /api/admin/systems/disable?name=          - выключить систему
+//	POST /api/admin/systems/rate?name=&every=|&hz= - частота (без параметров - каждый тик)
+//	POST /api/admin/systems/release?name=          - досрочно выпустить систему из карантина
+//	GET  /api/admin/alerts                         - последние оповещения сторожа систем
+func registerSystemsAdmin(gameTicker *game.GameTicker, token string) {
+	http.HandleFunc("/api/admin/systems", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, http.StatusOK, gameTicker.ListSystems())
+	}))
+
+	for path, enabled := range map[string]bool{"/api/admin/systems/enable": true, "/api/admin/systems/disable": false} {
+		enabled := enabled
+		http.HandleFunc(path, adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			writeSystemResult(w, gameTicker, gameTicker.SetSystemEnabled(r.URL.Query().Get("name"), enabled))
+		}))
+	}
+
+	http.HandleFunc("/api/admin/systems/rate", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		var rate game.SystemRate
+		if raw := query.Get("every"); raw != "" {
+			every, err := strconv.Atoi(raw)
+			if err != nil {
+				http.Error(w, "Invalid every", http.StatusBadRequest)
+				return
+			}
+			rate.EveryTicks = every
+		}
+		if raw := query.Get("hz"); raw != "" {
+			hz, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				http.Error(w, "Invalid hz", http.StatusBadRequest)
+				return
+			}
+			rate.Hz = hz
+		}
+
+		writeSystemResult(w, gameTicker, gameTicker.SetSystemRate(query.Get("name"), rate))
+	}))
+
+	http.HandleFunc("/api/admin/systems/release", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeSystemResult(w, gameTicker, gameTicker.ReleaseSystem(r.URL.Query().Get("name")))
+	}))
+
+	http.HandleFunc("/api/admin/alerts", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, http.StatusOK, gameTicker.Alerts())
+	}))
+}
+
+// writeSystemResult отвечает списком систем или ошибкой изменения системы
+func writeSystemResult(w http.ResponseWriter, gameTicker *game.GameTicker, err error) {
+	switch {
+	case errors.Is(err, game.ErrSystemNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		writeJSON(w, http.StatusOK, gameTicker.ListSystems())
+	}
+}
+
+// registerTraceAdmin регистрирует эндпоинты трасс тиков в формате Chrome trace-event
+// (открываются в chrome://tracing, ui.perfetto.dev или speedscope):
+//
+//	GET /api/admin/traces              - список сохраненных медленных тиков
+//	GET /api/admin/traces/slow?tick=   - трасса медленного тика (по умолчанию последнего)
+//	GET /api/admin/traces/recent       - трасса последних тиков из кольцевого буфера
+func registerTraceAdmin(tracer *game.TickTracer, token string) {
+	http.HandleFunc("/api/admin/traces", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, http.StatusOK, tracer.SlowTraces())
+	}))
+
+	http.HandleFunc("/api/admin/traces/slow", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var trace *game.TickTrace
+		var found bool
+		if raw := r.URL.Query().Get("tick"); raw != "" {
+			tick, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid tick", http.StatusBadRequest)
+				return
+			}
+			trace, found = tracer.SlowTrace(tick)
+		} else {
+			trace, found = tracer.LatestSlowTrace()
+		}
+		if !found {
+			http.Error(w, "Trace not found", http.StatusNotFound)
+			return
+		}
+
+		writeTrace(w, fmt.Sprintf("tick-%d.trace.json", trace.Tick), []*game.TickTrace{trace})
+	}))
+
+	http.HandleFunc("/api/admin/traces/recent", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeTrace(w, "recent-ticks.trace.json", tracer.Recent())
+	}))
+}
+
+// writeTrace отдает трассы файлом для скачивания
+func writeTrace(w http.ResponseWriter, filename string, traces []*game.TickTrace) {
+	data, err := game.ChromeTrace(traces)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	if _, err := w.Write(data); err != nil {
+		log.Printf("[Admin] Ошибка записи трассы: %v", err)
+	}
+}
+
+// inputLog журнал ввода для админского эндпоинта
+type inputLog struct {
+	Stats   game.InputStats     `json:"stats"`
+	Applied []game.AppliedInput `json:"applied"`
+}
+
+// registerInputAdmin регистрирует эндпоинт журнала команд игроков:
+//
+//	GET /api/admin/input?since= - примененные команды начиная с тика since и счетчики очереди
+func registerInputAdmin(inputSystem *game.InputSystem, token string) {
+	http.HandleFunc("/api/admin/input", adminHandler(token, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var since uint64
+		if raw := r.URL.Query().Get("since"); raw != "" {
+			parsed, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid since", http.StatusBadRequest)
+				return
+			}
+			since = parsed
+		}
+		writeJSON(w, http.StatusOK, inputLog{Stats: inputSystem.Stats(), Applied: inputSystem.History(since)})
+	}))
+}
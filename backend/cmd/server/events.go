@@ -0,0 +1,40 @@
+package main
+
+import (
+	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/transport/ws"
+)
+
+// subscribeClients рассылает клиентам события игрового цикла
+func subscribeClients(bus *game.EventBus, wsServer *ws.WSServer) {
+	game.Subscribe(bus, func(e game.FoodSpawned) {
+		wsServer.BroadcastFoodSpawned(e.Food)
+	})
+	game.Subscribe(bus, func(e game.FoodConsumed) {
+		wsServer.BroadcastFoodConsumed(e.PlayerID, e.FoodID, e.MassGain)
+	})
+	game.Subscribe(bus, func(e game.MassChanged) {
+		if e.SizeChanged() {
+			wsServer.BroadcastPlayerSizeUpdate(e.PlayerID, e.NewRadius, e.NewMass)
+		}
+	})
+	game.Subscribe(bus, func(e game.PlayerEaten) {
+		wsServer.BroadcastPlayerEaten(e.EaterID, e.VictimID, e.MassGain)
+	})
+	game.Subscribe(bus, func(e game.PlayerDied) {
+		wsServer.BroadcastPlayerDied(e.PlayerID, e.KillerID)
+		wsServer.HandlePlayerDied(e.PlayerID)
+	})
+	game.Subscribe(bus, func(e game.InputApplied) {
+		wsServer.AckInput(e.PlayerID, e.Cmd, e.Seq, e.ClientTime, e.Tick)
+	})
+	game.Subscribe(bus, func(e game.ObjectDespawned) {
+		wsServer.BroadcastObjectDespawned(e.DespawnEvent)
+	})
+	game.Subscribe(bus, func(e game.PlayerOutOfBounds) {
+		wsServer.BroadcastPlayerOutOfBounds(e.BoundsEvent)
+	})
+	game.Subscribe(bus, func(e game.TerrainPatched) {
+		wsServer.BroadcastTerrainPatch(e.TerrainPatch)
+	})
+}
@@ -2,73 +2,229 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
 
 	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/metrics"
+	"x-cells/backend/internal/persistence"
 	"x-cells/backend/internal/transport"
 	"x-cells/backend/internal/transport/ws"
 	"x-cells/backend/internal/world"
 )
 
 func main() {
+	levelPath := flag.String("level", "", "Путь к JSON-файлу уровня (по умолчанию - тестовый террейн)")
+	saveDir := flag.String("save-dir", "saves", "Директория сохранений мира")
+	materialsPath := flag.String("materials", "", "Путь к JSON-файлу библиотеки материалов (по умолчанию - встроенные)")
+	physicsConfigPath := flag.String("physics-config", "", "Путь к JSON-файлу конфигурации физики (поверх значений по умолчанию)")
+	room := flag.String("room", "", "Имя комнаты для переопределений физики из раздела rooms")
+	killY := flag.Float64("kill-y", float64(world.DefaultKillPlaneY), "Высота плоскости смерти (ниже нее игрок возрождается)")
+	boundsAction := flag.String("bounds-action", string(world.BoundsActionRespawn), "Действие при выходе игрока за границы мира: clamp, push или respawn")
+	adminToken := flag.String("admin-token", os.Getenv("XCELLS_ADMIN_TOKEN"), "Токен админских эндпоинтов /api/admin/* (пусто - только с localhost)")
+	fixedTimestep := flag.Bool("fixed-timestep", true, "Фиксированный шаг игрового цикла (системы всегда получают номинальный deltaTime)")
+	maxCatchUp := flag.Int("max-catchup", game.DefaultMaxCatchUpTicks, "Максимум тиков догона за кадр в режиме фиксированного шага")
+	systemWorkers := flag.Int("system-workers", 0, "Горутин для параллельного выполнения независимых систем (0 - по числу CPU, 1 - последовательно)")
+	systemBudget := flag.Duration("system-budget", 0, "Бюджет времени системы на тик для сторожа систем (0 - сторож выключен)")
+	systemHangTimeout := flag.Duration("system-hang-timeout", time.Second, "Через сколько сторож бросает зависшую систему и отправляет ее в карантин")
+	inputsPerTick := flag.Int("inputs-per-tick", game.DefaultInputsPerTick, "Сколько команд игрока применяется за один тик (остальные ждут следующего)")
+	lagCompensation := flag.Bool("lag-compensation", true, "Проверять поедание еды по состоянию, которое видел клиент (по RTT)")
+	eatOverlap := flag.Float64("eat-overlap", game.DefaultPlayerEatConfig().MinOverlap, "Доля перекрытия меньшего игрока, при которой его можно съесть (0.5 - центр на поверхности большего)")
+	eatMassAdvantage := flag.Float64("eat-mass-advantage", game.DefaultPlayerEatConfig().MinMassAdvantage, "Насколько съедающий игрок должен быть массивнее жертвы (0.25 - на 25%)")
+	impactSpeed := flag.Float64("impact-crater-speed", game.DefaultTerrainImpactConfig().MinSpeed, "Скорость падения, с которой приземление игрока оставляет воронку в террейне (0 - отключить)")
+	traceTicks := flag.Int("trace-ticks", game.DefaultTraceCapacity, "Сколько последних тиков хранить в трассировщике")
+	autosaveInterval := flag.Duration("autosave", 30*time.Second, "Интервал автосохранения мира (0 - отключить)")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 15*time.Second, "Сколько ждать корректной остановки сервера по SIGINT/SIGTERM")
+	flag.Parse()
+
 	ctx := context.Background()
 
+	// Загружаем конфигурацию физики: значения по умолчанию, файл сервера, XCELLS_PHYSICS_*, комната
+	physicsSettings, err := world.LoadPhysicsSettings(*physicsConfigPath, os.LookupEnv)
+	if err != nil {
+		log.Fatalf("Failed to load physics config: %v", err)
+	}
+	physicsConfig := physicsSettings.Resolve(*room)
+
 	// Инициализация физического клиента
-	physicsClient, err := transport.NewPhysicsClient(ctx, "localhost:50051")
+	// Вызовы физики попадают в метрики и в трассы тиков
+	physicsLatency := metrics.NewHistogramVec(metrics.RPCBuckets)
+	tickTracer := game.NewTickTracer(*traceTicks)
+	physicsClient, err := transport.NewPhysicsClient(ctx, "localhost:50051", grpc.WithChainUnaryInterceptor(
+		transport.LatencyInterceptor(physicsLatency),
+		transport.TraceInterceptor(tickTracer),
+	))
 	if err != nil {
 		log.Fatalf("Failed to create physics client: %v", err)
 	}
-	defer physicsClient.Close()
 
 	// Создаем менеджер игрового мира
 	worldManager := world.NewManager()
 
-	// Создаем фабрику объектов
-	factory := world.NewFactory(worldManager, physicsClient)
+	// Создаем фабрику объектов. Системы получают ее через worldManager.GetFactory()
+	factory := world.NewFactory(worldManager, physicsClient, physicsConfig)
+	worldManager.SetFactory(factory)
+
+	// Загружаем библиотеку материалов и передаем ее в Bullet до создания объектов
+	if *materialsPath != "" {
+		materials, err := world.LoadMaterialRegistry(*materialsPath)
+		if err != nil {
+			log.Fatalf("Failed to load materials: %v", err)
+		}
+		factory.SetMaterialRegistry(materials)
+	}
+	if err := factory.SyncMaterialsToBullet(); err != nil {
+		log.Printf("Failed to sync materials to Bullet: %v", err)
+	}
 
 	// Создаем сериализатор
 	serializer := ws.NewWorldSerializer(worldManager)
 
-	// Создаем тестовые объекты
-	testObjectsCreator := world.NewTestObjectsCreator(factory)
-	testObjectsCreator.CreateAll(50.0)
+	// Восстанавливаем мир из последнего сохранения, если оно есть
+	saveStore := persistence.NewStore(*saveDir, 5)
+	snapshot, err := saveStore.LoadLatest()
+	if err != nil && !errors.Is(err, persistence.ErrNoSave) {
+		log.Fatalf("Failed to load world save: %v", err)
+	}
+
+	// Описание уровня нужно и при восстановлении из сохранения (границы мира)
+	var level *world.Level
+	if *levelPath != "" {
+		level, err = world.LoadLevel(*levelPath)
+		if err != nil {
+			log.Fatalf("Failed to load level: %v", err)
+		}
+	}
+
+	// Загружаем сохранение, уровень или создаем тестовые объекты
+	if snapshot != nil {
+		persistence.RestoreWorld(snapshot, factory)
+	} else if level != nil {
+		if err := level.Build(factory); err != nil {
+			log.Fatalf("Failed to build level: %v", err)
+		}
+	} else {
+		testObjectsCreator := world.NewTestObjectsCreator(factory)
+		testObjectsCreator.CreateAll(50.0)
+	}
 
 	// === НОВОЕ: Создаем GameTicker и системы ===
 	logger := log.New(os.Stdout, "[X-CELLS] ", log.LstdFlags)
 	gameTicker := game.NewGameTicker(20, worldManager, logger) // 20 TPS
+	if *fixedTimestep {
+		gameTicker.EnableFixedTimestep(*maxCatchUp)
+	}
+	gameTicker.SetSystemWorkers(*systemWorkers)
+	if *systemBudget > 0 {
+		watchdog := game.DefaultWatchdogConfig()
+		watchdog.Budget = *systemBudget
+		watchdog.HangTimeout = *systemHangTimeout
+		gameTicker.EnableWatchdog(watchdog)
+	}
+	gameTicker.SetTracer(tickTracer)
+
+	// Команды игроков применяются в начале тика
+	inputSystem := game.NewInputSystem(physicsClient, gameTicker, logger)
+	inputSystem.SetLimits(*inputsPerTick, 0)
+	gameTicker.RegisterSystem(inputSystem)
 
 	// Добавляем простую систему еды
 	simpleFoodSystem := game.NewSimpleFoodSystem(gameTicker, logger)
+	simpleFoodSystem.SetLagCompensation(*lagCompensation)
 	gameTicker.RegisterSystem(simpleFoodSystem)
 
+	// Поедание игроков игроками
+	playerEatSystem := game.NewPlayerEatSystem(gameTicker, worldManager, logger)
+	playerEatSystem.SetConfig(game.PlayerEatConfig{MinOverlap: *eatOverlap, MinMassAdvantage: *eatMassAdvantage})
+	gameTicker.RegisterSystem(playerEatSystem)
+
 	// === НОВОЕ: Добавляем систему синхронизации позиций игроков ===
 	physicsPositionSync := game.NewPhysicsPositionSyncSystem(physicsClient, gameTicker, worldManager, logger)
 	gameTicker.RegisterSystem(physicsPositionSync)
 
+	// Система удаления объектов с истекшим временем жизни
+	despawnSystem := game.NewDespawnSystem(gameTicker, worldManager, logger)
+	gameTicker.RegisterSystem(despawnSystem)
+
+	// Система границ мира: границы из уровня или по краям террейна
+	var boundsSystem *game.BoundsSystem
+	action := world.BoundsAction(*boundsAction)
+	switch action {
+	case world.BoundsActionClamp, world.BoundsActionPush, world.BoundsActionRespawn:
+	default:
+		log.Fatalf("Unknown bounds action: %s", *boundsAction)
+	}
+	if level != nil && level.Bounds != nil {
+		boundsSystem = game.NewBoundsSystem(*level.Bounds, action, physicsClient, gameTicker, worldManager, logger)
+	} else if terrain := worldManager.GetTerrain(); terrain != nil {
+		bounds := world.BoundsFromTerrain(terrain, float32(*killY))
+		boundsSystem = game.NewBoundsSystem(bounds, action, physicsClient, gameTicker, worldManager, logger)
+	} else {
+		log.Printf("World bounds are disabled: no terrain and no bounds in level")
+	}
+	if boundsSystem != nil {
+		gameTicker.RegisterSystem(boundsSystem)
+	}
+
+	// Изменение рельефа во время игры (воронки, холмы)
+	terrainDeformer := game.NewTerrainDeformer(worldManager, logger)
+
+	// Воронки от быстрых приземлений игроков
+	impactConfig := game.DefaultTerrainImpactConfig()
+	impactConfig.MinSpeed = *impactSpeed
+	terrainImpactSystem := game.NewTerrainImpactSystem(gameTicker, terrainDeformer, logger)
+	terrainImpactSystem.SetConfig(impactConfig)
+	gameTicker.RegisterSystem(terrainImpactSystem)
+
+	// Восстанавливаем еду и показатели игроков
+	if snapshot != nil {
+		persistence.RestoreGame(snapshot, gameTicker, simpleFoodSystem)
+	}
+
+	// Периодическое автосохранение мира
+	autosave := persistence.NewAutosaveSystem(saveStore, *autosaveInterval, worldManager, gameTicker, simpleFoodSystem, logger)
+	gameTicker.RegisterSystem(autosave)
+
+	// Периодические сводки систем в лог
+	gameTicker.RegisterSystem(game.NewStatsLogSystem(simpleFoodSystem, physicsPositionSync))
+
 	// Запускаем игровой цикл
 	if err := gameTicker.Start(); err != nil {
 		log.Fatalf("Failed to start game ticker: %v", err)
 	}
-	defer gameTicker.Stop()
 
 	// Сервер для WS
-	wsServer := ws.NewWSServer(worldManager, physicsClient, serializer)
-
-	// Связываем WebSocket сервер с системой еды (взаимная связь)
-	wsServer.SetFoodSystem(simpleFoodSystem)
-	simpleFoodSystem.SetBroadcaster(wsServer)
+	wsServer := ws.NewWSServer(worldManager, factory, physicsClient, serializer, physicsConfig)
 
 	// === НОВОЕ: Связываем WSServer с GameTicker для управления игроками ===
 	wsServer.SetGameTicker(gameTicker)
 
-	// === НОВОЕ: Связываем GameTicker с WSServer для отправки обновлений размера игроков ===
-	gameTicker.SetPlayerBroadcaster(wsServer)
+	// Клиенты получают события еды, игроков, удаления объектов и изменения террейна из шины событий
+	subscribeClients(gameTicker.Events(), wsServer)
+
+	// Команды клиентов попадают в физику только через очередь игрового цикла
+	wsServer.SetInputQueue(inputSystem)
 
 	http.HandleFunc("/ws", wsServer.HandleWS)
 
+	// Пауза, пошаговое выполнение и управление системами игрового цикла для отладки
+	registerTickerAdmin(gameTicker, *adminToken)
+	registerSystemsAdmin(gameTicker, *adminToken)
+	registerTraceAdmin(tickTracer, *adminToken)
+	registerInputAdmin(inputSystem, *adminToken)
+
+	// Метрики для Prometheus
+	registerMetrics(gameTicker, worldManager, simpleFoodSystem, inputSystem, wsServer, physicsLatency)
+
 	// Эндпоинты для управления имитацией сети
 	http.HandleFunc("/api/network-sim/enable", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "POST" {
@@ -149,8 +305,26 @@ func main() {
 	http.Handle("/", http.StripPrefix("/", fs))
 
 	log.Printf("Serving static files from: %s\n", staticDir)
+	// Остановка по SIGINT/SIGTERM
+	signalCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	server := &http.Server{Addr: ":8080"}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
 	log.Println("Server starting on :8080")
-	if err := http.ListenAndServe(":8080", nil); err != nil {
-		log.Fatal(err)
+
+	exitCode := 0
+	select {
+	case <-signalCtx.Done():
+		log.Println("Shutdown signal received")
+	case err := <-serveErr:
+		log.Printf("HTTP server error: %v", err)
+		exitCode = 1
 	}
+
+	shutdown(*shutdownTimeout, server, wsServer, gameTicker, physicsClient)
+	os.Exit(exitCode)
 }
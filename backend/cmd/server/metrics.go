@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/metrics"
+	"x-cells/backend/internal/transport/ws"
+	"x-cells/backend/internal/world"
+)
+
+// objectKinds роли объектов для метрики xcells_world_objects
+var objectKinds = []world.ObjectKind{world.KindPlayer, world.KindFood, world.KindTerrain, world.KindProp, world.KindProjectile}
+
+// registerMetrics регистрирует эндпоинт /metrics в текстовом формате Prometheus
+func registerMetrics(gameTicker *game.GameTicker, worldManager *world.Manager, foodSystem *game.SimpleFoodSystem,
+	inputSystem *game.InputSystem, wsServer *ws.WSServer, physicsLatency *metrics.HistogramVec) {
+	http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", metrics.ContentType)
+		e := metrics.NewExposition(w)
+
+		gameTicker.WriteMetrics(e)
+
+		e.Header("xcells_world_objects", "gauge", "Объекты мира по ролям")
+		for _, kind := range objectKinds {
+			e.Sample("xcells_world_objects", float64(worldManager.CountByKind(kind)), metrics.Label{Name: "kind", Value: string(kind)})
+		}
+		e.Gauge("xcells_food_items", "Еда в мире", float64(len(foodSystem.GetFoodItems())))
+		inputSystem.WriteMetrics(e)
+
+		wsServer.WriteMetrics(e)
+
+		e.HistogramVec("xcells_physics_rpc_duration_seconds", "Длительность вызовов bullet-server", "method", physicsLatency)
+
+		if err := e.Err(); err != nil {
+			log.Printf("[Metrics] Ошибка записи метрик: %v", err)
+		}
+	})
+}
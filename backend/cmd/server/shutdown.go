@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/transport"
+	"x-cells/backend/internal/transport/ws"
+)
+
+// shutdown останавливает сервер по порядку: сначала перестаем принимать запросы
+// и отключаем клиентов, затем завершаем игровой цикл (автосохранение пишет мир
+// на диск) и только после этого закрываем соединение с физикой, которое еще
+// нужно системам в последнем тике
+func shutdown(timeout time.Duration, server *http.Server, wsServer *ws.WSServer,
+	gameTicker *game.GameTicker, physicsClient transport.IPhysicsClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	log.Printf("Shutting down (timeout %v)", timeout)
+
+	// Hijacked WebSocket соединения http.Server не ждет, их закрывает wsServer
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("HTTP server shutdown error: %v", err)
+	}
+	if err := wsServer.Shutdown(ctx); err != nil {
+		log.Printf("WebSocket shutdown error: %v", err)
+	}
+	if err := gameTicker.Shutdown(ctx); err != nil {
+		log.Printf("Game ticker shutdown error: %v", err)
+	}
+	if err := physicsClient.Close(); err != nil {
+		log.Printf("Physics client close error: %v", err)
+	}
+
+	log.Println("Server stopped")
+}
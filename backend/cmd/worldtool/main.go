@@ -0,0 +1,243 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"x-cells/backend/internal/world"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Использование: worldtool <команда> [флаги]
+
+Команды:
+  heightmap   Загрузить heightmap и показать статистику
+  physics     Показать итоговую конфигурацию физики (или отличия между комнатами)
+  scatter     Рассчитать расстановку объектов уровня без запуска сервера
+`)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "heightmap":
+		err = runHeightmap(os.Args[2:])
+	case "physics":
+		err = runPhysics(os.Args[2:])
+	case "scatter":
+		err = runScatter(os.Args[2:])
+	case "help", "-h", "--help":
+		usage()
+	default:
+		fmt.Fprintf(os.Stderr, "Неизвестная команда: %s\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatalf("Ошибка: %v", err)
+	}
+}
+
+// runHeightmap загружает heightmap (напрямую или из уровня) и печатает статистику
+func runHeightmap(args []string) error {
+	fs := flag.NewFlagSet("heightmap", flag.ExitOnError)
+	var (
+		levelPath = fs.String("level", "", "JSON-файл уровня (параметры heightmap берутся из него)")
+		format    = fs.String("format", "", "Формат файла: png или raw16 (по умолчанию - по расширению)")
+		width     = fs.Int("width", 0, "Ширина raw heightmap (0 - квадратная)")
+		depth     = fs.Int("depth", 0, "Глубина raw heightmap (0 - квадратная)")
+		minHeight = fs.Float64("min", 0, "Высота, соответствующая черному цвету")
+		maxHeight = fs.Float64("max", 1, "Высота, соответствующая белому цвету")
+		scaleX    = fs.Float64("scale-x", 1, "Масштаб по X")
+		scaleY    = fs.Float64("scale-y", 1, "Масштаб по Y")
+		scaleZ    = fs.Float64("scale-z", 1, "Масштаб по Z")
+	)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Использование: worldtool heightmap [флаги] <файл>\n       worldtool heightmap -level <уровень.json>\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	var terrain *world.TerrainData
+	var source string
+
+	if *levelPath != "" {
+		level, err := world.LoadLevel(*levelPath)
+		if err != nil {
+			return err
+		}
+		obj, err := level.LoadTerrain()
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			return fmt.Errorf("в уровне %s не задан террейн", *levelPath)
+		}
+		terrain = obj.Shape.Terrain
+		source = level.Terrain.Heightmap
+	} else {
+		if fs.NArg() != 1 {
+			fs.Usage()
+			os.Exit(2)
+		}
+		source = fs.Arg(0)
+
+		var err error
+		terrain, err = world.LoadHeightmap(source, world.HeightmapOptions{
+			Format:    world.HeightmapFormat(*format),
+			Width:     int32(*width),
+			Depth:     int32(*depth),
+			MinHeight: float32(*minHeight),
+			MaxHeight: float32(*maxHeight),
+			ScaleX:    float32(*scaleX),
+			ScaleY:    float32(*scaleY),
+			ScaleZ:    float32(*scaleZ),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	stats := terrain.Stats()
+	minX, minZ, maxX, maxZ := terrain.Bounds()
+
+	fmt.Printf("Файл:          %s\n", source)
+	fmt.Printf("Размер сетки:  %d x %d\n", stats.Width, stats.Depth)
+	fmt.Printf("Масштаб:       %.2f x %.2f x %.2f\n", terrain.ScaleX, terrain.ScaleY, terrain.ScaleZ)
+	fmt.Printf("Размер в мире: %.2f x %.2f\n", maxX-minX, maxZ-minZ)
+	fmt.Printf("Высоты:        min %.2f, max %.2f, среднее %.2f\n", stats.MinHeight, stats.MaxHeight, stats.Mean)
+
+	return nil
+}
+
+// runPhysics печатает итоговую конфигурацию физики с источником каждого значения.
+// С -diff печатает только параметры, отличающиеся от значений по умолчанию
+// (или от комнаты -against).
+func runPhysics(args []string) error {
+	fs := flag.NewFlagSet("physics", flag.ExitOnError)
+	var (
+		configPath = fs.String("config", "", "JSON-файл конфигурации физики сервера")
+		room       = fs.String("room", "", "Комната, для которой показать конфигурацию")
+		diff       = fs.Bool("diff", false, "Показать только параметры, отличающиеся от базовых")
+		against    = fs.String("against", "", "С -diff: сравнить с комнатой вместо значений по умолчанию")
+		noEnv      = fs.Bool("no-env", false, "Не учитывать переменные окружения XCELLS_PHYSICS_*")
+	)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Использование: worldtool physics [-config файл] [-room комната] [-diff [-against комната]]\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	lookupEnv := os.LookupEnv
+	if *noEnv {
+		lookupEnv = nil
+	}
+	settings, err := world.LoadPhysicsSettings(*configPath, lookupEnv)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if !*diff {
+		fmt.Fprintln(w, "ПАРАМЕТР\tЗНАЧЕНИЕ\tИСТОЧНИК\tПЕРЕМЕННАЯ")
+		for _, value := range settings.Explain(*room) {
+			fmt.Fprintf(w, "%s\t%g\t%s\t%s\n", value.Key, value.Value, value.Source, world.PhysicsEnvName(value.Key))
+		}
+		if rooms := settings.Rooms(); len(rooms) > 0 {
+			fmt.Fprintf(w, "\nКомнаты с переопределениями: %v\n", rooms)
+		}
+		return nil
+	}
+
+	base := world.DefaultPhysicsConfig()
+	baseName := "по умолчанию"
+	if *against != "" {
+		base = settings.Resolve(*against)
+		baseName = *against
+	}
+	effective := settings.Resolve(*room)
+
+	keys := world.DiffPhysicsConfig(base, effective)
+	if len(keys) == 0 {
+		fmt.Fprintln(w, "Отличий нет")
+		return nil
+	}
+
+	fmt.Fprintf(w, "ПАРАМЕТР\t%s\tИТОГ\n", baseName)
+	for _, key := range keys {
+		from, _ := base.Get(key)
+		to, _ := effective.Get(key)
+		fmt.Fprintf(w, "%s\t%g\t%g\n", key, from, to)
+	}
+	return nil
+}
+
+// runScatter рассчитывает расстановку объектов уровня и печатает итог по правилам
+func runScatter(args []string) error {
+	fs := flag.NewFlagSet("scatter", flag.ExitOnError)
+	var (
+		seed    = fs.Int64("seed", -1, "Переопределить seed из уровня")
+		verbose = fs.Bool("v", false, "Печатать каждый объект")
+	)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Использование: worldtool scatter [флаги] <уровень.json>\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	level, err := world.LoadLevel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if level.Scatter == nil {
+		return fmt.Errorf("в уровне %s не задана расстановка объектов", fs.Arg(0))
+	}
+	obj, err := level.LoadTerrain()
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return fmt.Errorf("в уровне %s не задан террейн", fs.Arg(0))
+	}
+
+	config := *level.Scatter
+	if *seed >= 0 {
+		config.Seed = uint64(*seed)
+	}
+
+	props, err := world.ScatterProps(obj.Shape.Terrain, config)
+	if err != nil {
+		return err
+	}
+
+	counts := make([]int, len(config.Rules))
+	for _, prop := range props {
+		counts[prop.Rule]++
+		if *verbose {
+			fmt.Printf("%-24s (%.2f, %.2f, %.2f) размер %.2f\n",
+				prop.ID, prop.Position.X, prop.Position.Y, prop.Position.Z, prop.Size)
+		}
+	}
+
+	fmt.Printf("Seed: %d, всего объектов: %d\n", config.Seed, len(props))
+	for i, rule := range config.Rules {
+		fmt.Printf("  правило %d (%s): %d\n", i, rule.Type, counts[i])
+	}
+	return nil
+}
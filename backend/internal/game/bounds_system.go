@@ -0,0 +1,255 @@
+package game
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "x-cells/backend/internal/physics/generated"
+	"x-cells/backend/internal/world"
+)
+
+// BoundsPhysicsClient часть физического клиента, нужная системе границ
+type BoundsPhysicsClient interface {
+	ApplyImpulse(ctx context.Context, req *pb.ApplyImpulseRequest, opts ...grpc.CallOption) (*pb.ApplyImpulseResponse, error)
+	SetObjectTransform(ctx context.Context, req *pb.SetObjectTransformRequest, opts ...grpc.CallOption) (*pb.SetObjectTransformResponse, error)
+}
+
+const (
+	boundsCooldown        = time.Second            // Пауза перед повторной обработкой игрока: позиция из физики приходит не сразу
+	boundsRespawnDrop     = 5.0                    // Высота над землей при переносе игрока
+	boundsRespawnFallback = 80.0                   // Высота переноса, если террейн недоступен
+	defaultPushStrength   = 20.0                   // Импульс на единицу массы при выталкивании
+	boundsPhysicsTimeout  = 200 * time.Millisecond // Ожидание ответа Bullet на перенос или толчок игрока
+)
+
+// BoundsSystem следит, чтобы игроки не покидали границы мира и не падали ниже плоскости смерти.
+// Выход за границы обрабатывается настроенным действием, падение ниже плоскости - всегда
+// гибелью: объект игрока удаляется, публикуется PlayerDied, и транспорт возрождает игрока
+// с новым объектом и начальной массой, как после поедания.
+type BoundsSystem struct {
+	SystemClock
+	SystemContext
+
+	name         string
+	priority     int
+	bounds       world.WorldBounds
+	action       world.BoundsAction
+	pushStrength float64
+	physics      BoundsPhysicsClient
+	gameTicker   *GameTicker
+	worldManager *world.Manager
+	logger       *log.Logger
+
+	lastHandled map[string]time.Time
+}
+
+// NewBoundsSystem создает систему контроля границ мира
+func NewBoundsSystem(bounds world.WorldBounds, action world.BoundsAction, physics BoundsPhysicsClient,
+	gameTicker *GameTicker, worldManager *world.Manager, logger *log.Logger) *BoundsSystem {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &BoundsSystem{
+		name:         "BoundsSystem",
+		priority:     30, // После синхронизации позиций и игровых систем
+		bounds:       bounds,
+		action:       action,
+		pushStrength: defaultPushStrength,
+		physics:      physics,
+		gameTicker:   gameTicker,
+		worldManager: worldManager,
+		logger:       logger,
+		lastHandled:  make(map[string]time.Time),
+	}
+}
+
+// SetPushStrength задает силу выталкивания для действия push
+func (bs *BoundsSystem) SetPushStrength(strength float64) {
+	bs.pushStrength = strength
+}
+
+// Update проверяет позиции игроков
+func (bs *BoundsSystem) Update(deltaTime time.Duration) error {
+	now := bs.Now()
+	players := bs.gameTicker.GetAllPlayers()
+
+	// Объекты игроков по владельцу
+	objects := make(map[string]*world.WorldObject)
+	if bs.worldManager != nil {
+		for _, obj := range bs.worldManager.GetByKind(world.KindPlayer) {
+			objects[obj.OwnerID] = obj
+		}
+	}
+
+	for playerID := range bs.lastHandled {
+		if _, exists := players[playerID]; !exists {
+			delete(bs.lastHandled, playerID)
+		}
+	}
+
+	for playerID, player := range players {
+		pos := player.Position
+
+		var reason world.BoundsReason
+		action := bs.action
+		switch {
+		case bs.bounds.BelowKillPlane(float32(pos.Y)):
+			reason = world.BoundsReasonKillPlane
+			action = world.BoundsActionRespawn
+		case !bs.bounds.ContainsXZ(float32(pos.X), float32(pos.Z)):
+			reason = world.BoundsReasonOutside
+		default:
+			continue
+		}
+
+		if last, ok := bs.lastHandled[playerID]; ok && now.Sub(last) < boundsCooldown {
+			continue
+		}
+		bs.lastHandled[playerID] = now
+
+		objectID := playerID
+		mass := player.Mass
+		if obj, ok := objects[playerID]; ok {
+			objectID = obj.ID
+			if obj.Shape != nil && obj.Shape.Sphere != nil {
+				mass = float64(obj.Shape.Sphere.Mass)
+			}
+		}
+
+		from := world.Vector3{X: float32(pos.X), Y: float32(pos.Y), Z: float32(pos.Z)}
+		event := world.BoundsEvent{
+			PlayerID: playerID,
+			ObjectID: objectID,
+			Action:   action,
+			Reason:   reason,
+			From:     from,
+			To:       from,
+			Time:     now,
+		}
+
+		var err error
+		switch action {
+		case world.BoundsActionClamp:
+			x, z := bs.bounds.ClampXZ(float32(pos.X), float32(pos.Z), float32(player.Radius))
+			event.To, err = bs.teleport(playerID, objectID, float64(x), float64(z), player.Radius)
+		case world.BoundsActionPush:
+			err = bs.push(objectID, pos, player.Radius, mass)
+		default:
+			// Точку возрождения выбирает транспорт, To остается позицией гибели
+			bs.gameTicker.Events().Publish(bs.name, PlayerOutOfBounds{event})
+			bs.kill(playerID, objects[playerID], now)
+			bs.logger.Printf("[BoundsSystem] Игрок %s: %s -> %s в (%.1f, %.1f, %.1f)",
+				playerID, reason, action, pos.X, pos.Y, pos.Z)
+			continue
+		}
+
+		if err != nil {
+			bs.logger.Printf("[BoundsSystem] Ошибка обработки игрока %s (%s): %v", playerID, action, err)
+			continue
+		}
+
+		bs.logger.Printf("[BoundsSystem] Игрок %s: %s -> %s, (%.1f, %.1f, %.1f) -> (%.1f, %.1f, %.1f)",
+			playerID, reason, action, pos.X, pos.Y, pos.Z, event.To.X, event.To.Y, event.To.Z)
+		bs.gameTicker.Events().Publish(bs.name, PlayerOutOfBounds{event})
+	}
+
+	return nil
+}
+
+// kill удаляет игрока из игры, а его объект - из мира и Bullet, и публикует ObjectDespawned
+// (клиенты убирают объект) и PlayerDied без убийцы (транспорт возрождает игрока)
+func (bs *BoundsSystem) kill(playerID string, obj *world.WorldObject, now time.Time) {
+	if obj != nil && bs.worldManager != nil {
+		if factory := bs.worldManager.GetFactory(); factory != nil {
+			ctx, cancel := context.WithTimeout(bs.Context(), DespawnTimeout)
+			err := factory.RemoveObject(ctx, obj)
+			cancel()
+			if err != nil {
+				bs.logger.Printf("[BoundsSystem] Ошибка удаления объекта %s из Bullet: %v", obj.ID, err)
+			}
+		} else {
+			bs.worldManager.RemoveObject(obj.ID)
+		}
+	}
+	bs.gameTicker.RemovePlayer(playerID)
+
+	events := bs.gameTicker.Events()
+	if obj != nil {
+		events.Publish(bs.name, ObjectDespawned{world.DespawnEvent{
+			ObjectID: obj.ID,
+			Object:   obj,
+			Reason:   world.DespawnReasonKilled,
+			Time:     now,
+		}})
+	}
+	events.Publish(bs.name, PlayerDied{PlayerID: playerID})
+}
+
+// teleport переносит объект игрока в точку (x, z) над землей и сбрасывает его скорость
+func (bs *BoundsSystem) teleport(playerID, objectID string, x, z, radius float64) (world.Vector3, error) {
+	y := boundsRespawnFallback
+	if ground, ok := bs.gameTicker.GroundHeightAt(x, z); ok {
+		y = ground + radius + boundsRespawnDrop
+	}
+	target := world.Vector3{X: float32(x), Y: float32(y), Z: float32(z)}
+
+	ctx, cancel := context.WithTimeout(bs.Context(), boundsPhysicsTimeout)
+	defer cancel()
+	_, err := bs.physics.SetObjectTransform(ctx, &pb.SetObjectTransformRequest{
+		Id:            objectID,
+		Position:      &pb.Vector3{X: target.X, Y: target.Y, Z: target.Z},
+		ResetVelocity: true,
+	})
+	if err != nil {
+		return target, err
+	}
+
+	// Не ждем синхронизации из физики, чтобы не обработать игрока повторно по старой позиции
+	bs.gameTicker.UpdatePlayerPosition(playerID, Vector3{X: x, Y: y, Z: z})
+	if bs.worldManager != nil {
+		bs.worldManager.UpdateObjectPosition(objectID, target)
+	}
+	return target, nil
+}
+
+// push толкает объект игрока горизонтально к ближайшей точке внутри границ
+func (bs *BoundsSystem) push(objectID string, pos Vector3, radius, mass float64) error {
+	x, z := bs.bounds.ClampXZ(float32(pos.X), float32(pos.Z), float32(radius))
+	dx, dz := float64(x)-pos.X, float64(z)-pos.Z
+	length := math.Sqrt(dx*dx + dz*dz)
+	if length == 0 {
+		return nil
+	}
+
+	strength := bs.pushStrength * math.Max(mass, 1)
+	ctx, cancel := context.WithTimeout(bs.Context(), boundsPhysicsTimeout)
+	defer cancel()
+	_, err := bs.physics.ApplyImpulse(ctx, &pb.ApplyImpulseRequest{
+		Id: objectID,
+		Impulse: &pb.Vector3{
+			X: float32(dx / length * strength),
+			Z: float32(dz / length * strength),
+		},
+	})
+	return err
+}
+
+// GetName возвращает имя системы
+func (bs *BoundsSystem) GetName() string {
+	return bs.name
+}
+
+// GetPriority возвращает приоритет системы
+func (bs *BoundsSystem) GetPriority() int {
+	return bs.priority
+}
+
+// Access объявляет ресурсы системы для планировщика
+func (bs *BoundsSystem) Access() SystemAccess {
+	return SystemAccess{Writes: []string{ResourcePlayers, ResourceWorld, ResourcePhysics}}
+}
@@ -0,0 +1,163 @@
+package game
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "x-cells/backend/internal/physics/generated"
+	"x-cells/backend/internal/world"
+)
+
+type fakeBoundsPhysics struct {
+	impulses   map[string]*pb.Vector3
+	transforms map[string]*pb.SetObjectTransformRequest
+	unbounded  int // Вызовы без дедлайна
+}
+
+func newFakeBoundsPhysics() *fakeBoundsPhysics {
+	return &fakeBoundsPhysics{
+		impulses:   make(map[string]*pb.Vector3),
+		transforms: make(map[string]*pb.SetObjectTransformRequest),
+	}
+}
+
+func (f *fakeBoundsPhysics) ApplyImpulse(ctx context.Context, req *pb.ApplyImpulseRequest, opts ...grpc.CallOption) (*pb.ApplyImpulseResponse, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		f.unbounded++
+	}
+	f.impulses[req.Id] = req.Impulse
+	return &pb.ApplyImpulseResponse{Status: "OK"}, nil
+}
+
+func (f *fakeBoundsPhysics) SetObjectTransform(ctx context.Context, req *pb.SetObjectTransformRequest, opts ...grpc.CallOption) (*pb.SetObjectTransformResponse, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		f.unbounded++
+	}
+	f.transforms[req.Id] = req
+	return &pb.SetObjectTransformResponse{Status: "OK"}, nil
+}
+
+func TestBoundsSystem_Actions(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	bounds := world.WorldBounds{MinX: -50, MaxX: 50, MinZ: -50, MaxZ: 50, KillY: -20, Margin: 1}
+
+	tests := []struct {
+		name       string
+		action     world.BoundsAction
+		position   Vector3
+		wantAction world.BoundsAction
+		wantReason world.BoundsReason
+	}{
+		{"clamp", world.BoundsActionClamp, Vector3{X: 70, Y: 5, Z: 0}, world.BoundsActionClamp, world.BoundsReasonOutside},
+		{"push", world.BoundsActionPush, Vector3{X: 0, Y: 5, Z: -60}, world.BoundsActionPush, world.BoundsReasonOutside},
+		{"kill plane", world.BoundsActionPush, Vector3{X: 0, Y: -30, Z: 0}, world.BoundsActionRespawn, world.BoundsReasonKillPlane},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := world.NewManager()
+			manager.AddWorldObject(world.NewPlayerWithBounceSkill("player_1", world.Vector3{}, 2, 10, "#ff0000", world.PhysicsTypeBoth, 0))
+
+			ticker := NewGameTicker(20, manager, logger)
+			ticker.AddPlayerWithRadius("player_1", tt.position, 2)
+			ticker.AddPlayer("player_inside", Vector3{X: 10, Y: 5, Z: 10})
+
+			physics := newFakeBoundsPhysics()
+			system := NewBoundsSystem(bounds, tt.action, physics, ticker, manager, logger)
+			var events []PlayerOutOfBounds
+			var died []PlayerDied
+			var despawned []ObjectDespawned
+			Subscribe(ticker.Events(), func(e PlayerOutOfBounds) { events = append(events, e) })
+			Subscribe(ticker.Events(), func(e PlayerDied) { died = append(died, e) })
+			Subscribe(ticker.Events(), func(e ObjectDespawned) { despawned = append(despawned, e) })
+
+			system.Update(50 * time.Millisecond)
+			ticker.Events().Dispatch()
+
+			if len(events) != 1 {
+				t.Fatalf("Ожидали одно событие, получили %d", len(events))
+			}
+			event := events[0]
+			if event.PlayerID != "player_1" || event.Action != tt.wantAction || event.Reason != tt.wantReason {
+				t.Errorf("Неверное событие: %+v", event)
+			}
+
+			switch tt.wantAction {
+			case world.BoundsActionRespawn:
+				// Падение ниже плоскости смерти убивает игрока: возрождает его транспорт
+				if ticker.GetPlayer("player_1") != nil {
+					t.Error("Погибший игрок должен быть удален из игры")
+				}
+				if _, exists := manager.GetObject("player_1"); exists {
+					t.Error("Объект погибшего игрока должен быть удален из мира")
+				}
+				if len(physics.transforms) != 0 {
+					t.Errorf("Погибшего игрока не нужно переносить, получили %+v", physics.transforms)
+				}
+				if len(died) != 1 || died[0] != (PlayerDied{PlayerID: "player_1"}) {
+					t.Errorf("Ожидали PlayerDied без убийцы, получили %+v", died)
+				}
+				if len(despawned) != 1 || despawned[0].ObjectID != "player_1" || despawned[0].Reason != world.DespawnReasonKilled {
+					t.Errorf("Ожидали удаление объекта игрока у клиентов, получили %+v", despawned)
+				}
+			case world.BoundsActionPush:
+				impulse := physics.impulses["player_1"]
+				if impulse == nil || impulse.Z <= 0 {
+					t.Errorf("Ожидали импульс к центру по +Z, получили %+v", impulse)
+				}
+			default:
+				transform := physics.transforms["player_1"]
+				if transform == nil || !transform.ResetVelocity {
+					t.Fatalf("Ожидали перенос со сбросом скорости, получили %+v", transform)
+				}
+				if !bounds.ContainsXZ(transform.Position.X, transform.Position.Z) {
+					t.Errorf("Новая позиция вне границ: %+v", transform.Position)
+				}
+				if tt.wantAction == world.BoundsActionClamp && transform.Position.X != 47 {
+					t.Errorf("Ожидали X = MaxX - радиус - отступ = 47, получили %.2f", transform.Position.X)
+				}
+				if pos := ticker.GetPlayer("player_1").Position; !bounds.ContainsXZ(float32(pos.X), float32(pos.Z)) {
+					t.Errorf("Позиция в GameTicker не обновлена: %+v", pos)
+				}
+			}
+
+			if physics.unbounded != 0 {
+				t.Errorf("Вызовы физики должны быть ограничены по времени, без дедлайна: %d", physics.unbounded)
+			}
+
+			// Повторно игрок не обрабатывается до окончания паузы
+			system.Update(50 * time.Millisecond)
+			ticker.Events().Dispatch()
+			if len(events) != 1 {
+				t.Errorf("Игрок обработан повторно: %d событий", len(events))
+			}
+		})
+	}
+}
+
+func TestBoundsFromTerrain(t *testing.T) {
+	terrain := &world.TerrainData{
+		HeightData: []float32{0, 10, 10, 20},
+		Width:      2,
+		Depth:      2,
+		ScaleX:     100,
+		ScaleY:     1,
+		ScaleZ:     100,
+		MinHeight:  0,
+		MaxHeight:  20,
+	}
+
+	bounds := world.BoundsFromTerrain(terrain, 0)
+	if bounds.MinX != -50 || bounds.MaxX != 50 || bounds.MinZ != -50 || bounds.MaxZ != 50 {
+		t.Errorf("Неверные границы: %+v", bounds)
+	}
+	// Самая низкая точка террейна -10 (высоты центрированы), плоскость смерти должна быть ниже
+	if bounds.KillY >= -10 {
+		t.Errorf("Плоскость смерти %.2f выше самой низкой точки террейна", bounds.KillY)
+	}
+}
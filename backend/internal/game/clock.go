@@ -0,0 +1,147 @@
+package game
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock источник игрового времени. Игровой цикл и системы берут время только из него,
+// поэтому в тестах время можно продвигать вручную (ManualClock) без ожидания.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) ClockTicker
+}
+
+// ClockTicker периодический таймер, созданный Clock
+type ClockTicker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// ClockAware реализуют системы, которым нужны часы игрового цикла.
+// GameTicker передает им свои часы при регистрации.
+type ClockAware interface {
+	SetClock(clock Clock)
+}
+
+// RealClock часы реального времени
+type RealClock struct{}
+
+// Now возвращает текущее время
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTicker создает таймер реального времени
+func (RealClock) NewTicker(d time.Duration) ClockTicker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time { return t.ticker.C }
+func (t realTicker) Stop()               { t.ticker.Stop() }
+
+// ManualClock часы, время которых меняется только через Advance и Set
+type ManualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	tickers []*manualTicker
+}
+
+// NewManualClock создает ручные часы, показывающие start
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now возвращает текущее время часов
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance сдвигает время вперед и срабатывает таймеры, чей срок наступил.
+// Как и time.Ticker, таймер не копит пропущенные срабатывания.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	for _, t := range c.tickers {
+		t.fire(c.now)
+	}
+}
+
+// Set устанавливает время часов (только вперед; назад - без срабатывания таймеров)
+func (c *ManualClock) Set(now time.Time) {
+	c.Advance(now.Sub(c.Now()))
+}
+
+// NewTicker создает таймер, срабатывающий при продвижении часов
+func (c *ManualClock) NewTicker(d time.Duration) ClockTicker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &manualTicker{
+		clock:    c,
+		period:   d,
+		next:     c.now.Add(d),
+		ch:       make(chan time.Time, 1),
+		isActive: true,
+	}
+	c.tickers = append(c.tickers, t)
+	return t
+}
+
+type manualTicker struct {
+	clock    *ManualClock
+	period   time.Duration
+	next     time.Time
+	ch       chan time.Time
+	isActive bool
+}
+
+// fire вызывается под блокировкой часов
+func (t *manualTicker) fire(now time.Time) {
+	if !t.isActive || now.Before(t.next) {
+		return
+	}
+	for !now.Before(t.next) {
+		t.next = t.next.Add(t.period)
+	}
+
+	select {
+	case t.ch <- now:
+	default: // Получатель не успел прочитать прошлое срабатывание
+	}
+}
+
+func (t *manualTicker) C() <-chan time.Time { return t.ch }
+
+func (t *manualTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.isActive = false
+}
+
+// SystemClock встраивается в системы и реализует ClockAware.
+// Без установленных часов используется реальное время.
+type SystemClock struct {
+	clock Clock
+}
+
+// SetClock устанавливает часы системы
+func (c *SystemClock) SetClock(clock Clock) {
+	c.clock = clock
+}
+
+// Now возвращает текущее время по часам системы
+func (c *SystemClock) Now() time.Time {
+	if c.clock == nil {
+		return time.Now()
+	}
+	return c.clock.Now()
+}
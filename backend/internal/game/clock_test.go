@@ -0,0 +1,71 @@
+package game
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestManualClock_Ticker(t *testing.T) {
+	clock := NewManualClock(time.Unix(1000, 0))
+	ticker := clock.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	clock.Advance(30 * time.Millisecond)
+	select {
+	case <-ticker.C():
+		t.Fatal("Таймер не должен срабатывать раньше срока")
+	default:
+	}
+
+	// Срабатывание несет время часов; пропущенные периоды не копятся
+	clock.Advance(200 * time.Millisecond)
+	select {
+	case fired := <-ticker.C():
+		if !fired.Equal(time.Unix(1000, 0).Add(230 * time.Millisecond)) {
+			t.Errorf("Неверное время срабатывания: %v", fired)
+		}
+	default:
+		t.Fatal("Таймер должен сработать")
+	}
+	select {
+	case <-ticker.C():
+		t.Error("Пропущенные срабатывания не должны копиться")
+	default:
+	}
+}
+
+func TestGameTicker_ManualClockRunsSystemsInstantly(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	clock := NewManualClock(time.Unix(1000, 0))
+
+	gameTicker := NewGameTicker(20, nil, logger)
+	gameTicker.SetClock(clock)
+	gameTicker.EnableFixedTimestep(DefaultMaxCatchUpTicks)
+
+	// Система, зарегистрированная до и после SetClock, получает часы цикла
+	foodSystem := NewSimpleFoodSystem(gameTicker, log.New(os.Stdout, "[TEST] ", 0))
+	gameTicker.RegisterSystem(foodSystem)
+	if !foodSystem.Now().Equal(clock.Now()) {
+		t.Fatal("Система должна получить часы цикла при регистрации")
+	}
+
+	// 100 секунд игрового времени без ожидания
+	for i := 0; i < 2000; i++ {
+		clock.Advance(50 * time.Millisecond)
+		gameTicker.Tick()
+	}
+
+	if gameTicker.GetTickCount() != 2000 {
+		t.Errorf("Ожидали 2000 тиков, получили %d", gameTicker.GetTickCount())
+	}
+	if gameTicker.GetSimulationTime() != 100*time.Second {
+		t.Errorf("Ожидали 100s игрового времени, получили %v", gameTicker.GetSimulationTime())
+	}
+
+	// Еда появляется на первом тике и затем каждые 2 секунды
+	if count := len(foodSystem.GetFoodItems()); count != 50 {
+		t.Errorf("Ожидали 50 единиц еды за 100 секунд, получили %d", count)
+	}
+}
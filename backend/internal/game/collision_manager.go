@@ -3,6 +3,8 @@ package game
 import (
 	"log"
 	"time"
+
+	"x-cells/backend/internal/world"
 )
 
 // CollisionManagerSystem система управления коллизиями для всех объектов
@@ -35,14 +37,9 @@ func (cms *CollisionManagerSystem) SetFoodSystem(foodSystem *FoodSystem) {
 
 // Update обновляет систему коллизий
 func (cms *CollisionManagerSystem) Update(deltaTime time.Duration) error {
-	// Синхронизируем игроков в коллайдере
+	// Синхронизируем игроков в коллайдере (еда добавляется в коллайдер при создании)
 	cms.syncPlayersToCollider()
 
-	// Синхронизируем еду (если система еды доступна)
-	if cms.foodSystem != nil {
-		cms.syncFoodToCollider()
-	}
-
 	// Обрабатываем коллизии еды с игроками
 	if cms.foodSystem != nil {
 		cms.processFoodConsumption()
@@ -55,25 +52,13 @@ func (cms *CollisionManagerSystem) Update(deltaTime time.Duration) error {
 func (cms *CollisionManagerSystem) syncPlayersToCollider() {
 	players := cms.gameTicker.GetAllPlayers()
 
-	// Логируем каждые 5 секунд
-	if cms.gameTicker.GetTickCount()%100 == 0 { // 5 секунд при 20 TPS
-		cms.logger.Printf("[CollisionManager] Синхронизация игроков: %d в GameTicker, %d в коллайдере",
-			len(players), cms.collider.GetObjectCount())
-	}
-
 	for playerID, player := range players {
-		// Отладочное логирование для первого игрока или периодически
-		if playerID == "test_player_1" || cms.gameTicker.GetTickCount()%100 == 0 {
-			cms.logger.Printf("[CollisionManager] Синхронизация игрока %s в позиции (%.1f, %.1f, %.1f)",
-				playerID, player.Position.X, player.Position.Y, player.Position.Z)
-		}
-
 		// Создаем объект коллизии для игрока
 		collisionObj := &CollidableObject{
 			ID:       playerID,
 			Position: player.Position,
 			Radius:   player.Radius,
-			Type:     "player",
+			Type:     world.KindPlayer,
 			Mass:     float64(player.Score), // Масса = очки
 			IsStatic: false,
 		}
@@ -83,18 +68,20 @@ func (cms *CollisionManagerSystem) syncPlayersToCollider() {
 	}
 }
 
-// syncFoodToCollider синхронизирует еду в коллайдере (еда уже добавляется при создании)
-func (cms *CollisionManagerSystem) syncFoodToCollider() {
-	// Еда добавляется в коллайдер при создании в FoodSystem
-	// Здесь мы можем проверить консистентность
-	if cms.gameTicker.GetTickCount()%1000 == 0 { // Каждые 50 секунд при 20 TPS
-		foodInCollider := len(cms.collider.GetObjectsByType("food"))
-		foodInSystem := len(cms.foodSystem.GetFoodItems())
-
-		if foodInCollider != foodInSystem {
-			cms.logger.Printf("[CollisionManager] ПРЕДУПРЕЖДЕНИЕ: рассинхронизация еды - в коллайдере %d, в системе %d",
-				foodInCollider, foodInSystem)
-		}
+// LogStats выводит состояние коллайдера и проверяет, что еда в нем совпадает с едой
+// в хранилище (по расписанию StatsLogSystem)
+func (cms *CollisionManagerSystem) LogStats() {
+	cms.logger.Printf("[CollisionManager] Игроков %d, объектов в коллайдере %d",
+		Count[PlayerState](cms.gameTicker.Entities()), cms.collider.GetObjectCount())
+
+	if cms.foodSystem == nil {
+		return
+	}
+	foodInCollider := len(cms.collider.GetObjectsByType(world.KindFood))
+	foodInSystem := cms.foodSystem.foodCount()
+	if foodInCollider != foodInSystem {
+		cms.logger.Printf("[CollisionManager] ПРЕДУПРЕЖДЕНИЕ: рассинхронизация еды - в коллайдере %d, в системе %d",
+			foodInCollider, foodInSystem)
 	}
 }
 
@@ -105,34 +92,17 @@ func (cms *CollisionManagerSystem) processFoodConsumption() {
 		return
 	}
 
-	cms.foodSystem.foodMutex.Lock()
-	defer cms.foodSystem.foodMutex.Unlock()
-
 	var consumedFood []string
 	consumedSet := make(map[string]bool) // Защита от дублирования
 
-	// Отладочное логирование каждые 5 секунд
-	if cms.gameTicker.GetTickCount()%100 == 0 { // 5 секунд при 20 TPS
-		foodCount := len(cms.foodSystem.foodItems)
-		colliderFoodCount := len(cms.collider.GetObjectsByType("food"))
-		cms.logger.Printf("[CollisionManager] ОТЛАДКА: игроков %d, еды в системе %d, еды в коллайдере %d",
-			len(players), foodCount, colliderFoodCount)
-	}
-
 	// Используем spatial hashing для эффективного поиска коллизий
 	for playerID, player := range players {
 		// Получаем только близлежащую еду из spatial grid
 		nearbyObjects := cms.collider.GetNearbyObjects(player.Position, player.Radius*2)
 
-		// Отладочное логирование для первого игрока
-		if playerID == "test_player_1" || cms.gameTicker.GetTickCount()%100 == 0 {
-			cms.logger.Printf("[CollisionManager] ОТЛАДКА: игрок %s (радиус %.2f) в позиции (%.1f,%.1f,%.1f), найдено объектов рядом: %d",
-				playerID, player.Radius, player.Position.X, player.Position.Y, player.Position.Z, len(nearbyObjects))
-		}
-
 		for _, obj := range nearbyObjects {
-			// Проверяем только объекты типа "food"
-			if obj.Type != "food" {
+			// Проверяем только еду
+			if obj.Type != world.KindFood {
 				continue
 			}
 
@@ -141,8 +111,8 @@ func (cms *CollisionManagerSystem) processFoodConsumption() {
 				continue
 			}
 
-			food, exists := cms.foodSystem.foodItems[obj.ID]
-			if !exists {
+			food := cms.foodSystem.GetFood(obj.ID)
+			if food == nil {
 				// Еда уже удалена, но объект еще в коллайдере - очищаем
 				cms.collider.RemoveObject(obj.ID)
 				cms.logger.Printf("[CollisionManager] ОТЛАДКА: еда %s удалена из коллайдера (рассинхронизация)", obj.ID)
@@ -164,7 +134,7 @@ func (cms *CollisionManagerSystem) processFoodConsumption() {
 			if distance < minDistance && player.Radius >= food.Radius {
 				// Игрок съел еду!
 				cms.logger.Printf("[CollisionManager] ПОЕДАНИЕ: игрок %s съел еду %s!", playerID, obj.ID)
-				cms.foodSystem.consumeFood(playerID, player, food)
+				cms.foodSystem.consumeFood(playerID, food)
 				consumedFood = append(consumedFood, obj.ID)
 				consumedSet[obj.ID] = true
 
@@ -175,11 +145,6 @@ func (cms *CollisionManagerSystem) processFoodConsumption() {
 		}
 	}
 
-	// Удаляем съеденную еду из foodItems
-	for _, foodID := range consumedFood {
-		delete(cms.foodSystem.foodItems, foodID)
-	}
-
 	if len(consumedFood) > 0 {
 		cms.logger.Printf("[CollisionManager] Обработано поедание: %d объектов еды", len(consumedFood))
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math"
 	"sync"
+
+	"x-cells/backend/internal/world"
 )
 
 // Distance возвращает расстояние между двумя точками
@@ -16,13 +18,13 @@ func (v Vector3) Distance(other Vector3) float64 {
 
 // CollidableObject представляет объект, который может участвовать в коллизиях
 type CollidableObject struct {
-	ID       string  // Уникальный идентификатор
-	Position Vector3 // Позиция в мире
-	Radius   float64 // Радиус для сферической коллизии
-	Type     string  // Тип объекта (player, food, powerup и т.д.)
-	Mass     float64 // Масса объекта (для игровой логики)
-	IsStatic bool    // Статичный объект (еда) или динамичный (игрок)
-	GridX    int     // Координаты в сетке для spatial partitioning
+	ID       string           // Уникальный идентификатор
+	Position Vector3          // Позиция в мире
+	Radius   float64          // Радиус для сферической коллизии
+	Type     world.ObjectKind // Роль объекта (player, food и т.д.)
+	Mass     float64          // Масса объекта (для игровой логики)
+	IsStatic bool             // Статичный объект (еда) или динамичный (игрок)
+	GridX    int              // Координаты в сетке для spatial partitioning
 	GridY    int
 	GridZ    int
 }
@@ -251,7 +253,7 @@ func (cd *ColliderDispatcher) GetObjectCount() int {
 }
 
 // GetObjectsByType возвращает объекты определенного типа
-func (cd *ColliderDispatcher) GetObjectsByType(objectType string) []*CollidableObject {
+func (cd *ColliderDispatcher) GetObjectsByType(objectType world.ObjectKind) []*CollidableObject {
 	cd.mutex.RLock()
 	defer cd.mutex.RUnlock()
 
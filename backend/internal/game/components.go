@@ -0,0 +1,35 @@
+package game
+
+import "time"
+
+// Общие компоненты игровых сущностей: игроки, еда и другие объекты
+// хранят положение, радиус и массу одинаково.
+type (
+	// Position положение центра сущности в мире
+	Position Vector3
+	// Radius радиус сферы сущности
+	Radius float64
+	// Mass масса сущности
+	Mass float64
+	// Velocity скорость сущности. Есть только у движущихся сущностей, например у падающей еды
+	Velocity Vector3
+)
+
+// PlayerState компонент игрока
+type PlayerState struct {
+	ID       string
+	Health   float64
+	Score    int64
+	LastSeen time.Time
+	RTT      time.Duration // Сглаженная задержка клиента (ping/pong), 0 - не измерена
+
+	ResumeToken string // Секрет, по которому игрок вернет показатели после перезапуска сервера
+}
+
+// FoodState компонент еды
+type FoodState struct {
+	ID        string
+	Color     string
+	Type      FoodType // Тип еды FoodSystem; еда SimpleFoodSystem всегда FoodBasic
+	SpawnTime time.Time
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"x-cells/backend/internal/world"
+)
+
+// DespawnTimeout ограничивает ожидание ответа Bullet при удалении объекта, чтобы
+// зависший вызов физики не задерживал тик
+const DespawnTimeout = 200 * time.Millisecond
+
+// DespawnSystem удаляет объекты с истекшим временем жизни из мира и Bullet и публикует
+// ObjectDespawned, по которому объект убирают клиенты
+type DespawnSystem struct {
+	SystemClock
+	SystemContext
+
+	name         string
+	priority     int
+	gameTicker   *GameTicker
+	worldManager *world.Manager
+	logger       *log.Logger
+}
+
+// NewDespawnSystem создает систему удаления объектов по TTL
+func NewDespawnSystem(gameTicker *GameTicker, worldManager *world.Manager, logger *log.Logger) *DespawnSystem {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &DespawnSystem{
+		name:         "DespawnSystem",
+		priority:     90, // После игровых систем, до отправки обновлений клиентам
+		gameTicker:   gameTicker,
+		worldManager: worldManager,
+		logger:       logger,
+	}
+}
+
+// Update удаляет объекты, время жизни которых истекло
+func (ds *DespawnSystem) Update(deltaTime time.Duration) error {
+	now := ds.Now()
+	for _, obj := range ds.worldManager.GetExpiredObjects(now) {
+		ds.Despawn(obj, world.DespawnReasonExpired)
+	}
+	return nil
+}
+
+// Despawn удаляет объект из мира и физики и публикует ObjectDespawned
+func (ds *DespawnSystem) Despawn(obj *world.WorldObject, reason world.DespawnReason) {
+	if factory := ds.worldManager.GetFactory(); factory != nil {
+		ctx, cancel := context.WithTimeout(ds.Context(), DespawnTimeout)
+		err := factory.RemoveObject(ctx, obj)
+		cancel()
+		if err != nil {
+			ds.logger.Printf("[DespawnSystem] Ошибка удаления объекта %s из Bullet: %v", obj.ID, err)
+		}
+	} else {
+		ds.worldManager.RemoveObject(obj.ID)
+	}
+
+	ds.logger.Printf("[DespawnSystem] Объект %s удален (%s)", obj.ID, reason)
+
+	ds.gameTicker.Events().Publish(ds.name, ObjectDespawned{world.DespawnEvent{
+		ObjectID: obj.ID,
+		Object:   obj,
+		Reason:   reason,
+		Time:     ds.Now(),
+	}})
+}
+
+// GetName возвращает имя системы
+func (ds *DespawnSystem) GetName() string {
+	return ds.name
+}
+
+// GetPriority возвращает приоритет системы
+func (ds *DespawnSystem) GetPriority() int {
+	return ds.priority
+}
+
+// Access объявляет ресурсы системы для планировщика
+func (ds *DespawnSystem) Access() SystemAccess {
+	return SystemAccess{Writes: []string{ResourceWorld, ResourcePhysics}}
+}
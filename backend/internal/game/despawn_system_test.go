@@ -0,0 +1,106 @@
+package game
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"x-cells/backend/internal/world"
+)
+
+func TestDespawnSystem_RemovesExpiredObjects(t *testing.T) {
+	manager := world.NewManager()
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	// Игровые часы далеко от реального времени: TTL отсчитывается только по ним
+	clock := NewManualClock(time.Unix(1000, 0))
+
+	expired := world.NewSphere("projectile", world.Vector3{}, 1, 1, "#ff0000", world.PhysicsTypeAmmo).
+		WithTTL(clock.Now(), time.Second)
+	alive := world.NewSphere("dropped_mass", world.Vector3{}, 1, 1, "#00ff00", world.PhysicsTypeAmmo).
+		WithTTL(clock.Now(), time.Hour)
+	permanent := world.NewSphere("rock", world.Vector3{}, 1, 1, "#888888", world.PhysicsTypeAmmo)
+
+	for _, obj := range []*world.WorldObject{expired, alive, permanent} {
+		manager.AddWorldObject(obj)
+	}
+
+	gameTicker := NewGameTicker(20, manager, logger)
+	despawn := NewDespawnSystem(gameTicker, manager, logger)
+	despawn.SetClock(clock)
+	var events []ObjectDespawned
+	Subscribe(gameTicker.Events(), func(e ObjectDespawned) { events = append(events, e) })
+
+	if err := despawn.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления: %v", err)
+	}
+	if _, exists := manager.GetObject("projectile"); !exists {
+		t.Fatal("TTL объекта еще не истек по игровым часам")
+	}
+
+	clock.Advance(2 * time.Second)
+	if err := despawn.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления: %v", err)
+	}
+	gameTicker.Events().Dispatch()
+
+	if _, exists := manager.GetObject("projectile"); exists {
+		t.Error("Объект с истекшим TTL должен быть удален")
+	}
+	if _, exists := manager.GetObject("dropped_mass"); !exists {
+		t.Error("Объект с неистекшим TTL должен остаться")
+	}
+	if _, exists := manager.GetObject("rock"); !exists {
+		t.Error("Объект без TTL должен остаться")
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Ожидали 1 событие удаления, получили %d", len(events))
+	}
+	if event := events[0]; event.ObjectID != "projectile" || event.Reason != world.DespawnReasonExpired {
+		t.Errorf("Неверное событие удаления: %+v", event)
+	}
+
+	// TTL можно задать уже созданному объекту
+	if !manager.SetObjectTTL("rock", clock.Now(), 0) {
+		t.Fatal("SetObjectTTL должен найти объект")
+	}
+	despawn.Update(50 * time.Millisecond)
+	if _, exists := manager.GetObject("rock"); exists {
+		t.Error("Объект с нулевым TTL должен быть удален на следующем тике")
+	}
+}
+
+func TestFoodSystem_ExpiresThroughDespawnSystem(t *testing.T) {
+	manager := world.NewManager()
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	clock := NewManualClock(time.Unix(1000, 0))
+
+	gameTicker := NewGameTicker(20, manager, logger)
+	foodSystem := NewFoodSystem(gameTicker, logger)
+	foodSystem.SetClock(clock)
+	foodSystem.SetFoodTTL(time.Second)
+
+	despawn := NewDespawnSystem(gameTicker, manager, logger)
+	despawn.SetClock(clock)
+
+	if err := foodSystem.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления еды: %v", err)
+	}
+	if len(foodSystem.GetFoodItems()) != 1 || manager.CountByKind(world.KindFood) != 1 {
+		t.Fatalf("Еда должна появиться и в FoodSystem, и в мире")
+	}
+
+	clock.Advance(2 * time.Second)
+	if err := despawn.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления: %v", err)
+	}
+	gameTicker.Events().Dispatch()
+
+	if count := manager.CountByKind(world.KindFood); count != 0 {
+		t.Errorf("Истекшая еда должна быть удалена из мира, осталось %d", count)
+	}
+	if items := foodSystem.GetFoodItems(); len(items) != 0 {
+		t.Errorf("FoodSystem должна забыть удаленную еду, осталось %d", len(items))
+	}
+}
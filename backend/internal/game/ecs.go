@@ -0,0 +1,321 @@
+package game
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Entity идентификатор сущности. Сущность - это только номер, данные хранятся
+// в компонентах. Номера не переиспользуются.
+type Entity uint64
+
+// Entities хранилище сущностей и их компонентов. Компоненты одного типа лежат
+// в отдельном плотном массиве, поэтому системы обходят их без копирования карт.
+//
+// Хранилище потокобезопасно. Обход (Each, Query2..Query4) выполняется под
+// эксклюзивной блокировкой: внутри колбэка можно менять компоненты через
+// переданные указатели, но нельзя вызывать другие методы хранилища.
+type Entities struct {
+	mu     sync.RWMutex
+	next   Entity
+	keys   map[Entity]string // Сущность -> внешний ID (ID игрока, еды)
+	byKey  map[string]Entity
+	stores map[reflect.Type]componentStore
+}
+
+// componentStore общая часть хранилищ компонентов разных типов
+type componentStore interface {
+	remove(id Entity)
+}
+
+// NewEntities создает пустое хранилище сущностей
+func NewEntities() *Entities {
+	return &Entities{
+		keys:   make(map[Entity]string),
+		byKey:  make(map[string]Entity),
+		stores: make(map[reflect.Type]componentStore),
+	}
+}
+
+// Create создает сущность с внешним ID key. Внешние ID уникальны для всех видов
+// сущностей; если ID уже занят, прежняя сущность удаляется вместе с компонентами.
+func (e *Entities) Create(key string) Entity {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if old, exists := e.byKey[key]; exists {
+		e.destroyLocked(old)
+	}
+
+	e.next++
+	id := e.next
+	e.keys[id] = key
+	e.byKey[key] = id
+	return id
+}
+
+// Lookup возвращает сущность по внешнему ID
+func (e *Entities) Lookup(key string) (Entity, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	id, ok := e.byKey[key]
+	return id, ok
+}
+
+// Key возвращает внешний ID сущности
+func (e *Entities) Key(id Entity) string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.keys[id]
+}
+
+// Alive проверяет, что сущность существует
+func (e *Entities) Alive(id Entity) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	_, ok := e.keys[id]
+	return ok
+}
+
+// Len возвращает количество сущностей
+func (e *Entities) Len() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return len(e.keys)
+}
+
+// Destroy удаляет сущность и все ее компоненты
+func (e *Entities) Destroy(id Entity) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.destroyLocked(id)
+}
+
+// DestroyKey удаляет сущность по внешнему ID
+func (e *Entities) DestroyKey(key string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	id, ok := e.byKey[key]
+	if !ok {
+		return false
+	}
+	return e.destroyLocked(id)
+}
+
+func (e *Entities) destroyLocked(id Entity) bool {
+	key, ok := e.keys[id]
+	if !ok {
+		return false
+	}
+	for _, store := range e.stores {
+		store.remove(id)
+	}
+	delete(e.keys, id)
+	delete(e.byKey, key)
+	return true
+}
+
+// components плотное хранилище компонентов типа T (sparse set)
+type components[T any] struct {
+	values   []T
+	entities []Entity
+	index    map[Entity]int
+}
+
+func (c *components[T]) get(id Entity) (*T, bool) {
+	i, ok := c.index[id]
+	if !ok {
+		return nil, false
+	}
+	return &c.values[i], true
+}
+
+func (c *components[T]) set(id Entity, value T) {
+	if i, ok := c.index[id]; ok {
+		c.values[i] = value
+		return
+	}
+	c.index[id] = len(c.values)
+	c.values = append(c.values, value)
+	c.entities = append(c.entities, id)
+}
+
+// remove переносит последний элемент на место удаленного
+func (c *components[T]) remove(id Entity) {
+	i, ok := c.index[id]
+	if !ok {
+		return
+	}
+
+	last := len(c.values) - 1
+	if i != last {
+		c.values[i] = c.values[last]
+		c.entities[i] = c.entities[last]
+		c.index[c.entities[i]] = i
+	}
+
+	var zero T
+	c.values[last] = zero
+	c.values = c.values[:last]
+	c.entities = c.entities[:last]
+	delete(c.index, id)
+}
+
+// storeOf возвращает хранилище компонентов типа T. Вызывается под блокировкой;
+// с create=false возвращает nil, если компонентов этого типа еще не было.
+func storeOf[T any](e *Entities, create bool) *components[T] {
+	key := reflect.TypeOf((*T)(nil)).Elem()
+	if store, ok := e.stores[key]; ok {
+		return store.(*components[T])
+	}
+	if !create {
+		return nil
+	}
+
+	store := &components[T]{index: make(map[Entity]int)}
+	e.stores[key] = store
+	return store
+}
+
+// Set добавляет или заменяет компонент сущности. Возвращает false, если сущности нет.
+func Set[T any](e *Entities, id Entity, value T) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, ok := e.keys[id]; !ok {
+		return false
+	}
+	storeOf[T](e, true).set(id, value)
+	return true
+}
+
+// Get возвращает копию компонента сущности
+func Get[T any](e *Entities, id Entity) (T, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var zero T
+	store := storeOf[T](e, false)
+	if store == nil {
+		return zero, false
+	}
+	value, ok := store.get(id)
+	if !ok {
+		return zero, false
+	}
+	return *value, true
+}
+
+// Has проверяет, есть ли у сущности компонент типа T
+func Has[T any](e *Entities, id Entity) bool {
+	_, ok := Get[T](e, id)
+	return ok
+}
+
+// Remove удаляет компонент типа T у сущности
+func Remove[T any](e *Entities, id Entity) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if store := storeOf[T](e, false); store != nil {
+		store.remove(id)
+	}
+}
+
+// Count возвращает количество сущностей с компонентом типа T
+func Count[T any](e *Entities) int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if store := storeOf[T](e, false); store != nil {
+		return len(store.values)
+	}
+	return 0
+}
+
+// Update изменяет компонент сущности под блокировкой. Возвращает false, если компонента нет.
+func Update[T any](e *Entities, id Entity, fn func(value *T)) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	store := storeOf[T](e, false)
+	if store == nil {
+		return false
+	}
+	value, ok := store.get(id)
+	if ok {
+		fn(value)
+	}
+	return ok
+}
+
+// Each обходит все компоненты типа T
+func Each[T any](e *Entities, fn func(id Entity, value *T)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	store := storeOf[T](e, false)
+	if store == nil {
+		return
+	}
+	for i, id := range store.entities {
+		fn(id, &store.values[i])
+	}
+}
+
+// Query2 обходит сущности, у которых есть компоненты A и B.
+// Обход идет по хранилищу A, поэтому первым лучше указывать более редкий компонент.
+func Query2[A, B any](e *Entities, fn func(id Entity, a *A, b *B)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	as, bs := storeOf[A](e, false), storeOf[B](e, false)
+	if as == nil || bs == nil {
+		return
+	}
+	for i, id := range as.entities {
+		if b, ok := bs.get(id); ok {
+			fn(id, &as.values[i], b)
+		}
+	}
+}
+
+// Query3 обходит сущности, у которых есть компоненты A, B и C
+func Query3[A, B, C any](e *Entities, fn func(id Entity, a *A, b *B, c *C)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	as, bs, cs := storeOf[A](e, false), storeOf[B](e, false), storeOf[C](e, false)
+	if as == nil || bs == nil || cs == nil {
+		return
+	}
+	for i, id := range as.entities {
+		b, okB := bs.get(id)
+		c, okC := cs.get(id)
+		if okB && okC {
+			fn(id, &as.values[i], b, c)
+		}
+	}
+}
+
+// Query4 обходит сущности, у которых есть компоненты A, B, C и D
+func Query4[A, B, C, D any](e *Entities, fn func(id Entity, a *A, b *B, c *C, d *D)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	as, bs, cs, ds := storeOf[A](e, false), storeOf[B](e, false), storeOf[C](e, false), storeOf[D](e, false)
+	if as == nil || bs == nil || cs == nil || ds == nil {
+		return
+	}
+	for i, id := range as.entities {
+		b, okB := bs.get(id)
+		c, okC := cs.get(id)
+		d, okD := ds.get(id)
+		if okB && okC && okD {
+			fn(id, &as.values[i], b, c, d)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package game
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestEntities_CreateSetGet(t *testing.T) {
+	entities := NewEntities()
+
+	id := entities.Create("player_1")
+	if !Set(entities, id, Position{X: 1, Y: 2, Z: 3}) {
+		t.Fatal("Set для существующей сущности должен вернуть true")
+	}
+	Set(entities, id, Radius(1.5))
+
+	pos, ok := Get[Position](entities, id)
+	if !ok || pos != (Position{X: 1, Y: 2, Z: 3}) {
+		t.Errorf("Ожидалась позиция (1, 2, 3), получили %+v (ok=%v)", pos, ok)
+	}
+	if Has[Mass](entities, id) {
+		t.Error("Компонента Mass не было")
+	}
+	if found, ok := entities.Lookup("player_1"); !ok || found != id {
+		t.Errorf("Lookup вернул %d, ожидали %d", found, id)
+	}
+	if entities.Key(id) != "player_1" {
+		t.Errorf("Key вернул %q", entities.Key(id))
+	}
+
+	// Get возвращает копию
+	pos.X = 100
+	if stored, _ := Get[Position](entities, id); stored.X != 1 {
+		t.Errorf("Изменение копии попало в хранилище: %+v", stored)
+	}
+
+	Update(entities, id, func(r *Radius) { *r *= 2 })
+	if r, _ := Get[Radius](entities, id); r != 3 {
+		t.Errorf("Ожидался радиус 3 после Update, получили %.1f", r)
+	}
+}
+
+func TestEntities_DestroyKeepsOtherComponents(t *testing.T) {
+	entities := NewEntities()
+
+	ids := make([]Entity, 3)
+	for i, key := range []string{"a", "b", "c"} {
+		ids[i] = entities.Create(key)
+		Set(entities, ids[i], Mass(float64(i+1)))
+	}
+
+	// Удаление из середины переносит последний компонент на освободившееся место
+	if !entities.Destroy(ids[0]) {
+		t.Fatal("Destroy должен удалить сущность")
+	}
+	if entities.Destroy(ids[0]) {
+		t.Error("Повторный Destroy должен вернуть false")
+	}
+	if entities.Alive(ids[0]) || Has[Mass](entities, ids[0]) {
+		t.Error("Сущность и ее компоненты должны быть удалены")
+	}
+	if _, ok := entities.Lookup("a"); ok {
+		t.Error("Внешний ID удаленной сущности не должен находиться")
+	}
+
+	for i, id := range ids[1:] {
+		if m, ok := Get[Mass](entities, id); !ok || float64(m) != float64(i+2) {
+			t.Errorf("Сущность %d: ожидали массу %d, получили %.1f (ok=%v)", id, i+2, m, ok)
+		}
+	}
+	if Count[Mass](entities) != 2 || entities.Len() != 2 {
+		t.Errorf("Ожидали 2 сущности, получили %d (компонентов %d)", entities.Len(), Count[Mass](entities))
+	}
+}
+
+func TestEntities_CreateReplacesKey(t *testing.T) {
+	entities := NewEntities()
+
+	old := entities.Create("player_1")
+	Set(entities, old, PlayerState{ID: "player_1", Score: 10})
+
+	id := entities.Create("player_1")
+	if id == old {
+		t.Fatal("Номера сущностей не должны переиспользоваться")
+	}
+	if entities.Alive(old) || Count[PlayerState](entities) != 0 {
+		t.Error("Прежняя сущность с тем же ID должна быть удалена вместе с компонентами")
+	}
+}
+
+func TestEntities_Query(t *testing.T) {
+	entities := NewEntities()
+
+	player := entities.Create("player_1")
+	Set(entities, player, PlayerState{ID: "player_1"})
+	Set(entities, player, Position{X: 1})
+	Set(entities, player, Radius(2))
+
+	food := entities.Create("food_1")
+	Set(entities, food, FoodState{ID: "food_1"})
+	Set(entities, food, Position{X: 5})
+	Set(entities, food, Radius(0.5))
+
+	// Общие компоненты обходятся одинаково для игроков и еды
+	var keys []string
+	Query2(entities, func(id Entity, pos *Position, radius *Radius) {
+		keys = append(keys, entities.keys[id])
+		pos.Y = float64(*radius)
+	})
+	sort.Strings(keys)
+	if len(keys) != 2 || keys[0] != "food_1" || keys[1] != "player_1" {
+		t.Errorf("Query2 должен обойти игрока и еду, получили %v", keys)
+	}
+	if pos, _ := Get[Position](entities, food); pos.Y != 0.5 {
+		t.Errorf("Изменение через указатель не сохранилось: %+v", pos)
+	}
+
+	var players int
+	Query3(entities, func(_ Entity, state *PlayerState, _ *Position, _ *Radius) {
+		players++
+		if state.ID != "player_1" {
+			t.Errorf("Query3 вернул лишнюю сущность %s", state.ID)
+		}
+	})
+	if players != 1 {
+		t.Errorf("Ожидали одного игрока, получили %d", players)
+	}
+
+	// Запрос по типу, которого еще нет, ничего не обходит
+	Query2(entities, func(Entity, *Mass, *Position) {
+		t.Error("Компонентов Mass нет")
+	})
+}
+
+func TestGameTicker_PlayersStoredAsEntities(t *testing.T) {
+	gameTicker := createTestGameTicker()
+	gameTicker.AddPlayerWithRadiusAndMass("player_1", Vector3{X: 1, Y: 2, Z: 3}, 2.0, 8.0)
+
+	id, ok := gameTicker.Entities().Lookup("player_1")
+	if !ok {
+		t.Fatal("Игрок должен храниться сущностью")
+	}
+	if m, _ := Get[Mass](gameTicker.Entities(), id); m != 8.0 {
+		t.Errorf("Ожидали массу 8, получили %.1f", m)
+	}
+
+	gameTicker.UpdatePlayerPosition("player_1", Vector3{X: 4, Y: 5, Z: 6})
+	if pos := gameTicker.GetPlayer("player_1").Position; pos != (Vector3{X: 4, Y: 5, Z: 6}) {
+		t.Errorf("GetPlayer вернул позицию %+v", pos)
+	}
+
+	gameTicker.RemovePlayer("player_1")
+	if gameTicker.GetPlayer("player_1") != nil || len(gameTicker.GetAllPlayers()) != 0 {
+		t.Error("Игрок должен быть удален")
+	}
+}
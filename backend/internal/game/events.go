@@ -0,0 +1,271 @@
+package game
+
+import (
+	"log"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+
+	"x-cells/backend/internal/world"
+)
+
+// Event событие игрового цикла. Системы публикуют события во время тика,
+// подписчики получают их в конце тика.
+type Event interface {
+	EventType() string
+}
+
+// PlayerJoined игрок добавлен в игру
+type PlayerJoined struct {
+	PlayerID string
+	Position Vector3
+	Radius   float64
+	Mass     float64
+}
+
+// PlayerLeft игрок удален из игры
+type PlayerLeft struct {
+	PlayerID string
+}
+
+// PlayerDied игрок погиб. KillerID пуст, если игрока никто не съел.
+type PlayerDied struct {
+	PlayerID string
+	KillerID string
+}
+
+// MassChanged изменились масса и радиус игрока
+type MassChanged struct {
+	PlayerID  string
+	OldMass   float64
+	NewMass   float64
+	OldRadius float64
+	NewRadius float64
+}
+
+// SizeUpdateThreshold изменение радиуса, о котором стоит сообщать клиентам
+const SizeUpdateThreshold = 0.1
+
+// SizeChanged сообщает, заметно ли изменился радиус игрока
+func (e MassChanged) SizeChanged() bool {
+	return math.Abs(e.NewRadius-e.OldRadius) > SizeUpdateThreshold
+}
+
+// FoodSpawned в мире появилась еда
+type FoodSpawned struct {
+	Food SimpleFood
+}
+
+// FoodConsumed игрок съел еду
+type FoodConsumed struct {
+	PlayerID string
+	FoodID   string
+	MassGain float64
+}
+
+// PlayerEaten игрок съеден другим игроком. За ним следует PlayerDied.
+type PlayerEaten struct {
+	EaterID  string
+	VictimID string
+	MassGain float64
+}
+
+// InputApplied команда игрока применена в тике
+type InputApplied struct {
+	PlayerID   string
+	Seq        uint64
+	Cmd        string
+	ClientTime int64
+	Tick       uint64
+}
+
+// ObjectDespawned объект удален из мира и физики; причина - в DespawnEvent.Reason
+type ObjectDespawned struct {
+	world.DespawnEvent
+}
+
+// PlayerOutOfBounds BoundsSystem обработала выход игрока за границы мира
+type PlayerOutOfBounds struct {
+	world.BoundsEvent
+}
+
+// TerrainPatched изменился участок heightmap террейна
+type TerrainPatched struct {
+	world.TerrainPatch
+}
+
+func (PlayerJoined) EventType() string      { return "player_joined" }
+func (PlayerLeft) EventType() string        { return "player_left" }
+func (PlayerDied) EventType() string        { return "player_died" }
+func (MassChanged) EventType() string       { return "mass_changed" }
+func (FoodSpawned) EventType() string       { return "food_spawned" }
+func (FoodConsumed) EventType() string      { return "food_consumed" }
+func (PlayerEaten) EventType() string       { return "player_eaten" }
+func (InputApplied) EventType() string      { return "input_applied" }
+func (ObjectDespawned) EventType() string   { return "object_despawned" }
+func (PlayerOutOfBounds) EventType() string { return "player_out_of_bounds" }
+func (TerrainPatched) EventType() string    { return "terrain_patched" }
+
+// EventSourceTicker источник событий, которые публикует сам GameTicker
+const EventSourceTicker = "GameTicker"
+
+// maxDispatchRounds ограничивает цепочки событий, опубликованных подписчиками.
+// Что не успело разослаться, переносится на следующий тик.
+const maxDispatchRounds = 8
+
+// EventBus типизированная шина событий игрового цикла. Публикация только ставит
+// событие в очередь; рассылка выполняется в конце тика в горутине игрового цикла.
+//
+// Порядок рассылки детерминирован даже при параллельном выполнении систем:
+// события упорядочиваются по положению системы-источника в плане тика, а события
+// одного источника - в порядке публикации. События вне систем (подключения
+// игроков, изменения из GameTicker) идут первыми.
+type EventBus struct {
+	mu       sync.Mutex
+	pending  []queuedEvent
+	seq      uint64
+	handlers map[reflect.Type][]eventHandler
+	nextID   uint64
+	ranks    map[string]int // Имя системы -> положение в плане тика
+	logger   *log.Logger
+}
+
+type queuedEvent struct {
+	source string
+	seq    uint64
+	event  Event
+}
+
+type eventHandler struct {
+	id uint64
+	fn func(Event)
+}
+
+// NewEventBus создает пустую шину событий
+func NewEventBus(logger *log.Logger) *EventBus {
+	return &EventBus{
+		handlers: make(map[reflect.Type][]eventHandler),
+		ranks:    make(map[string]int),
+		logger:   logger,
+	}
+}
+
+// Subscribe подписывает обработчик на события типа E. Обработчики одного типа
+// вызываются в порядке подписки. Возвращает функцию отписки.
+func Subscribe[E Event](bus *EventBus, handler func(E)) func() {
+	key := reflect.TypeOf((*E)(nil)).Elem()
+
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.nextID++
+	id := bus.nextID
+	bus.handlers[key] = append(bus.handlers[key], eventHandler{
+		id: id,
+		fn: func(event Event) { handler(event.(E)) },
+	})
+
+	return func() {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+
+		handlers := bus.handlers[key]
+		for i, h := range handlers {
+			if h.id == id {
+				bus.handlers[key] = append(handlers[:i:i], handlers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Publish ставит событие в очередь. source - имя системы, опубликовавшей событие.
+// Безопасно вызывать из любой горутины.
+func (b *EventBus) Publish(source string, event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	b.pending = append(b.pending, queuedEvent{source: source, seq: b.seq, event: event})
+}
+
+// Pending возвращает количество событий в очереди
+func (b *EventBus) Pending() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+// setOrder задает порядок систем для сортировки событий
+func (b *EventBus) setOrder(names []string) {
+	ranks := make(map[string]int, len(names))
+	for i, name := range names {
+		ranks[name] = i
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.ranks = ranks
+}
+
+// Dispatch рассылает накопленные события подписчикам и возвращает их количество.
+// События, опубликованные обработчиками, рассылаются в том же вызове следующей волной.
+func (b *EventBus) Dispatch() int {
+	dispatched := 0
+	for round := 0; round < maxDispatchRounds; round++ {
+		b.mu.Lock()
+		batch := b.pending
+		b.pending = nil
+		ranks := b.ranks
+		b.mu.Unlock()
+
+		if len(batch) == 0 {
+			return dispatched
+		}
+
+		rank := func(source string) int {
+			if r, ok := ranks[source]; ok {
+				return r
+			}
+			return -1
+		}
+		sort.SliceStable(batch, func(i, j int) bool {
+			ri, rj := rank(batch[i].source), rank(batch[j].source)
+			if ri != rj {
+				return ri < rj
+			}
+			return batch[i].seq < batch[j].seq
+		})
+
+		for _, queued := range batch {
+			b.deliver(queued)
+		}
+		dispatched += len(batch)
+	}
+
+	if pending := b.Pending(); pending > 0 {
+		b.logger.Printf("[EventBus] ПРЕДУПРЕЖДЕНИЕ: цепочка событий длиннее %d волн, %d событий перенесено на следующий тик",
+			maxDispatchRounds, pending)
+	}
+	return dispatched
+}
+
+// deliver вызывает обработчики события. Паника обработчика не мешает остальным.
+func (b *EventBus) deliver(queued queuedEvent) {
+	b.mu.Lock()
+	handlers := append([]eventHandler(nil), b.handlers[reflect.TypeOf(queued.event)]...)
+	b.mu.Unlock()
+
+	for _, h := range handlers {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					b.logger.Printf("[EventBus] КРИТИЧЕСКАЯ ОШИБКА в обработчике %s от %s: %v",
+						queued.event.EventType(), queued.source, r)
+				}
+			}()
+			h.fn(queued.event)
+		}()
+	}
+}
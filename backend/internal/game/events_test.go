@@ -0,0 +1,108 @@
+package game
+
+import (
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestEventBus_DispatchOrder(t *testing.T) {
+	bus := NewEventBus(log.New(io.Discard, "", 0))
+	bus.setOrder([]string{"physics", "food"})
+
+	var got []string
+	Subscribe(bus, func(e FoodConsumed) { got = append(got, e.FoodID) })
+
+	// Порядок публикации не важен: события сортируются по месту системы в плане
+	bus.Publish("food", FoodConsumed{FoodID: "food_1"})
+	bus.Publish("physics", FoodConsumed{FoodID: "physics_1"})
+	bus.Publish("food", FoodConsumed{FoodID: "food_2"})
+	bus.Publish(EventSourceTicker, FoodConsumed{FoodID: "ticker"})
+
+	if len(got) != 0 {
+		t.Fatal("События не должны рассылаться до Dispatch")
+	}
+	if n := bus.Dispatch(); n != 4 {
+		t.Errorf("Ожидали 4 разосланных события, получили %d", n)
+	}
+
+	want := []string{"ticker", "physics_1", "food_1", "food_2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Ожидали порядок %v, получили %v", want, got)
+	}
+}
+
+func TestEventBus_ChainsAndUnsubscribe(t *testing.T) {
+	bus := NewEventBus(log.New(io.Discard, "", 0))
+
+	var died []PlayerDied
+	Subscribe(bus, func(e PlayerLeft) {
+		panic("обработчик упал")
+	})
+	// Событие, опубликованное обработчиком, рассылается в том же Dispatch
+	Subscribe(bus, func(e PlayerLeft) {
+		bus.Publish("test", PlayerDied{PlayerID: e.PlayerID})
+	})
+	unsubscribe := Subscribe(bus, func(e PlayerDied) { died = append(died, e) })
+
+	bus.Publish("test", PlayerLeft{PlayerID: "player_1"})
+	if n := bus.Dispatch(); n != 2 {
+		t.Errorf("Ожидали 2 события с учетом цепочки, получили %d", n)
+	}
+	if len(died) != 1 || died[0].PlayerID != "player_1" {
+		t.Errorf("Паника соседнего обработчика не должна мешать цепочке: %+v", died)
+	}
+
+	unsubscribe()
+	bus.Publish("test", PlayerDied{PlayerID: "player_2"})
+	bus.Dispatch()
+	if len(died) != 1 {
+		t.Errorf("После отписки события не должны приходить: %+v", died)
+	}
+}
+
+func TestGameTicker_DispatchesEventsAtEndOfTick(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	gameTicker.SetSystemWorkers(4)
+
+	ran := map[string]bool{}
+	publisher := func(name string) *declaredSystem {
+		return &declaredSystem{name: name, priority: len(name),
+			access: SystemAccess{Writes: []string{name}},
+			update: func() {
+				gameTicker.Events().Publish(name, FoodConsumed{PlayerID: name})
+			}}
+	}
+	gameTicker.RegisterSystem(publisher("a"))
+	gameTicker.RegisterSystem(publisher("bb"))
+	gameTicker.RegisterSystem(&declaredSystem{name: "marker", priority: 10,
+		access: SystemAccess{Writes: []string{"marker"}},
+		update: func() { ran["marker"] = true }})
+
+	var got []string
+	Subscribe(gameTicker.Events(), func(e FoodConsumed) {
+		if !ran["marker"] {
+			t.Error("События должны рассылаться после выполнения всех систем")
+		}
+		got = append(got, e.PlayerID)
+	})
+	Subscribe(gameTicker.Events(), func(e PlayerJoined) { got = append(got, "joined:"+e.PlayerID) })
+
+	gameTicker.AddPlayer("player_1", Vector3{})
+	for i := 0; i < 20; i++ {
+		got = nil
+		ran["marker"] = false
+		gameTicker.Tick()
+
+		want := []string{"a", "bb"}
+		if i == 0 {
+			want = []string{"joined:player_1", "a", "bb"}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Тик %d: ожидали %v, получили %v", i, want, got)
+		}
+	}
+}
@@ -7,18 +7,24 @@ import (
 	"math/rand"
 	"sync"
 	"time"
+
+	"x-cells/backend/internal/world"
 )
 
-// FoodSystem система управления едой в игре
+// FoodSystem система управления едой в игре. Каждая еда регистрируется в мире как
+// объект с TTL: по истечении времени ее удаляет DespawnSystem, а FoodSystem по событию
+// ObjectDespawned удаляет сущность еды и убирает ее из коллайдера
 type FoodSystem struct {
+	SystemClock
+
 	name       string
 	priority   int
 	gameTicker *GameTicker
 	logger     *log.Logger
 
-	// Еда в мире
-	foodItems  map[string]*FoodItem
-	foodMutex  sync.RWMutex
+	// Еда хранится сущностями в gameTicker.Entities() с компонентом FoodState.
+	// Падающая еда дополнительно имеет компонент Velocity
+	foodMutex  sync.Mutex // Защищает спавн, nextFoodID и foodTTL
 	nextFoodID uint64
 
 	// Система коллизий для эффективной обработки
@@ -31,15 +37,18 @@ type FoodSystem struct {
 
 	// Зона спавна
 	spawnRadius float64 // Радиус зоны спавна
-	spawnHeight float64 // Высота спавна (еда падает сверху)
-	groundLevel float64 // Уровень земли
+	spawnHeight float64 // Высота спавна над землей (еда падает сверху)
+	groundLevel float64 // Уровень земли (если террейн недоступен)
 
 	// Физика еды
 	gravity    float64 // Гравитация для падающей еды
 	foodRadius float64 // Радиус еды по умолчанию
+
+	// Время жизни еды
+	foodTTL time.Duration
 }
 
-// FoodItem представляет объект еды
+// FoodItem снимок еды для сети и других систем
 type FoodItem struct {
 	ID         string
 	Position   Vector3
@@ -64,12 +73,11 @@ const (
 
 // NewFoodSystem создает новую систему еды
 func NewFoodSystem(gameTicker *GameTicker, logger *log.Logger) *FoodSystem {
-	return &FoodSystem{
+	fs := &FoodSystem{
 		name:       "FoodSystem",
 		priority:   20, // После физики, но до сети
 		gameTicker: gameTicker,
 		logger:     logger,
-		foodItems:  make(map[string]*FoodItem),
 		nextFoodID: 1,
 		// collider будет установлен через CollisionManagerSystem
 
@@ -79,13 +87,18 @@ func NewFoodSystem(gameTicker *GameTicker, logger *log.Logger) *FoodSystem {
 
 		// Зона спавна
 		spawnRadius: 500.0, // Радиус 50 единиц
-		spawnHeight: 20.0,  // Спавн на высоте 20
+		spawnHeight: 20.0,  // Спавн на 20 единиц выше земли
 		groundLevel: 1.0,   // Земля на уровне 1
 
 		// Физика
 		gravity:    9.8, // м/с²
 		foodRadius: 0.5, // Радиус еды
+
+		foodTTL: 60 * time.Second, // Еда исчезает через 60 секунд
 	}
+
+	Subscribe(gameTicker.Events(), fs.onObjectDespawned)
+	return fs
 }
 
 // Update обновляет систему еды
@@ -100,20 +113,12 @@ func (fs *FoodSystem) Update(deltaTime time.Duration) error {
 
 	// НЕ проверяем поедание еды - это делает CollisionManagerSystem
 
-	// Убираем старую еду
-	fs.cleanupOldFood()
-
-	// Логируем состояние каждые 10 секунд
-	if fs.gameTicker.GetTickCount()%200 == 0 { // 10 секунд при 20 TPS
-		fs.logFoodStats()
-	}
-
 	return nil
 }
 
 // spawnFood создает новую еду
 func (fs *FoodSystem) spawnFood() {
-	now := time.Now()
+	now := fs.Now()
 
 	// Проверяем, нужно ли спавнить еду
 	if now.Sub(fs.lastSpawn) < fs.spawnInterval {
@@ -124,7 +129,7 @@ func (fs *FoodSystem) spawnFood() {
 	defer fs.foodMutex.Unlock()
 
 	// Проверяем лимит еды
-	if len(fs.foodItems) >= fs.maxFood {
+	if fs.foodCount() >= fs.maxFood {
 		return
 	}
 
@@ -132,7 +137,6 @@ func (fs *FoodSystem) spawnFood() {
 
 	// Создаем новую еду
 	food := fs.createRandomFood()
-	fs.foodItems[food.ID] = food
 
 	fs.logger.Printf("[FoodSystem] Создана еда %s типа %s в позиции (%.1f, %.1f, %.1f)",
 		food.ID, fs.getFoodTypeName(food.Type), food.Position.X, food.Position.Y, food.Position.Z)
@@ -146,17 +150,18 @@ func (fs *FoodSystem) createRandomFood() *FoodItem {
 
 	x := math.Cos(angle) * distance
 	z := math.Sin(angle) * distance
-	y := fs.spawnHeight
+	y := fs.groundHeightAt(x, z) + fs.spawnHeight
 
 	// Определяем тип еды по вероятности
 	foodType := fs.getRandomFoodType()
 
+	now := fs.Now()
 	food := &FoodItem{
 		ID:         fmt.Sprintf("food_%d", fs.nextFoodID),
 		Position:   Vector3{X: x, Y: y, Z: z},
 		Velocity:   Vector3{X: 0, Y: 0, Z: 0}, // Начинает падать
 		Type:       foodType,
-		SpawnTime:  time.Now(),
+		SpawnTime:  now,
 		IsOnGround: false,
 	}
 
@@ -182,22 +187,128 @@ func (fs *FoodSystem) createRandomFood() *FoodItem {
 		food.Color = "#9370DB" // Фиолетовый
 	}
 
-	// Добавляем еду в систему коллизий (если доступна)
+	fs.addFood(food)
+	fs.addFoodObject(food, now)
+
+	return food
+}
+
+// addFood создает сущность еды и добавляет ее в коллайдер. Падающая еда получает Velocity
+func (fs *FoodSystem) addFood(food *FoodItem) {
+	entities := fs.gameTicker.Entities()
+	id := entities.Create(food.ID)
+	Set(entities, id, FoodState{ID: food.ID, Color: food.Color, Type: food.Type, SpawnTime: food.SpawnTime})
+	Set(entities, id, Position(food.Position))
+	Set(entities, id, Radius(food.Radius))
+	Set(entities, id, Mass(food.Mass))
+	if !food.IsOnGround {
+		Set(entities, id, Velocity(food.Velocity))
+	}
+	// Коллайдер только индексирует сущности еды по положению для поиска соседей
 	if fs.collider != nil {
-		collisionObj := &CollidableObject{
+		fs.collider.AddObject(&CollidableObject{
 			ID:       food.ID,
 			Position: food.Position,
 			Radius:   food.Radius,
-			Type:     "food",
+			Type:     world.KindFood,
 			Mass:     food.Mass,
-			IsStatic: false, // Еда движется (падает)
-		}
-		fs.collider.AddObject(collisionObj)
+			IsStatic: food.IsOnGround,
+		})
+	}
+}
+
+// foodCount возвращает количество еды в мире
+func (fs *FoodSystem) foodCount() int {
+	return Count[FoodState](fs.gameTicker.Entities())
+}
+
+// foodEntity возвращает сущность еды по ее ID
+func (fs *FoodSystem) foodEntity(foodID string) (Entity, bool) {
+	entities := fs.gameTicker.Entities()
+	id, ok := entities.Lookup(foodID)
+	if !ok || !Has[FoodState](entities, id) {
+		return 0, false
+	}
+	return id, true
+}
+
+// GetFood возвращает снимок еды или nil, если еды нет
+func (fs *FoodSystem) GetFood(foodID string) *FoodItem {
+	id, ok := fs.foodEntity(foodID)
+	if !ok {
+		return nil
 	}
 
+	entities := fs.gameTicker.Entities()
+	state, okState := Get[FoodState](entities, id)
+	pos, okPos := Get[Position](entities, id)
+	radius, okRadius := Get[Radius](entities, id)
+	mass, okMass := Get[Mass](entities, id)
+	if !okState || !okPos || !okRadius || !okMass {
+		return nil // Сущность удалили между чтениями
+	}
+
+	food := newFoodItemSnapshot(&state, &pos, &radius, &mass)
+	if velocity, falling := Get[Velocity](entities, id); falling {
+		food.Velocity = Vector3(velocity)
+		food.IsOnGround = false
+	}
 	return food
 }
 
+func newFoodItemSnapshot(state *FoodState, pos *Position, radius *Radius, mass *Mass) *FoodItem {
+	return &FoodItem{
+		ID:         state.ID,
+		Position:   Vector3(*pos),
+		Radius:     float64(*radius),
+		Mass:       float64(*mass),
+		Color:      state.Color,
+		Type:       state.Type,
+		SpawnTime:  state.SpawnTime,
+		IsOnGround: true,
+	}
+}
+
+// addFoodObject регистрирует еду в мире: время жизни отслеживает DespawnSystem.
+// Еда падает в FoodSystem, поэтому ее физика на клиенте, а не в Bullet
+func (fs *FoodSystem) addFoodObject(food *FoodItem, now time.Time) {
+	worldManager := fs.gameTicker.worldManager
+	if worldManager == nil {
+		return
+	}
+
+	obj := world.NewSphere(food.ID, toWorldVector(food.Position), float32(food.Radius), float32(food.Mass),
+		food.Color, world.PhysicsTypeAmmo)
+	obj.Kind = world.KindFood
+	if fs.foodTTL > 0 {
+		obj.WithTTL(now, fs.foodTTL)
+	}
+	worldManager.AddWorldObject(obj)
+}
+
+// removeFoodObject убирает съеденную еду из мира
+func (fs *FoodSystem) removeFoodObject(foodID string) {
+	if worldManager := fs.gameTicker.worldManager; worldManager != nil {
+		worldManager.RemoveObject(foodID)
+	}
+}
+
+// onObjectDespawned удаляет сущность еды, удаленной DespawnSystem, и убирает ее из коллайдера
+func (fs *FoodSystem) onObjectDespawned(event ObjectDespawned) {
+	if event.Object == nil || event.Object.Kind != world.KindFood {
+		return
+	}
+
+	id, exists := fs.foodEntity(event.ObjectID)
+	if !exists {
+		return
+	}
+	fs.gameTicker.Entities().Destroy(id)
+	if fs.collider != nil {
+		fs.collider.RemoveObject(event.ObjectID) // Удаляем из spatial grid
+	}
+}
+
 // getRandomFoodType возвращает случайный тип еды по вероятности
 func (fs *FoodSystem) getRandomFoodType() FoodType {
 	roll := rand.Float64() * 100
@@ -215,55 +326,83 @@ func (fs *FoodSystem) getRandomFoodType() FoodType {
 
 // updateFoodPhysics обновляет физику падающей еды
 func (fs *FoodSystem) updateFoodPhysics(deltaTime float64) {
-	fs.foodMutex.Lock()
-	defer fs.foodMutex.Unlock()
-
-	for _, food := range fs.foodItems {
-		if food.IsOnGround {
-			continue // Еда уже на земле
-		}
+	type movedFood struct {
+		entity   Entity
+		id       string
+		position Vector3
+		landed   bool
+	}
 
-		oldPos := food.Position
+	// Обход идет под блокировкой хранилища, поэтому коллайдер, мир и снятие
+	// Velocity с упавшей еды обновляем уже после него
+	var moved []movedFood
+	Query4(fs.gameTicker.Entities(), func(entity Entity, velocity *Velocity, state *FoodState, pos *Position, radius *Radius) {
+		oldPos := *pos
 
 		// Применяем гравитацию
-		food.Velocity.Y -= fs.gravity * deltaTime
+		velocity.Y -= fs.gravity * deltaTime
 
 		// Обновляем позицию
-		food.Position.X += food.Velocity.X * deltaTime
-		food.Position.Y += food.Velocity.Y * deltaTime
-		food.Position.Z += food.Velocity.Z * deltaTime
+		pos.X += velocity.X * deltaTime
+		pos.Y += velocity.Y * deltaTime
+		pos.Z += velocity.Z * deltaTime
 
 		// Проверяем столкновение с землей
-		if food.Position.Y <= fs.groundLevel+food.Radius {
-			food.Position.Y = fs.groundLevel + food.Radius
-			food.Velocity.Y = 0
-			food.IsOnGround = true
+		landed := false
+		groundHeight := fs.groundHeightAt(pos.X, pos.Z)
+		if pos.Y <= groundHeight+float64(*radius) {
+			pos.Y = groundHeight + float64(*radius)
+			velocity.Y = 0
+			landed = true
+		}
+
+		if oldPos != *pos || landed {
+			moved = append(moved, movedFood{entity: entity, id: state.ID, position: Vector3(*pos), landed: landed})
+		}
+	})
+
+	for _, food := range moved {
+		if food.landed {
+			Remove[Velocity](fs.gameTicker.Entities(), food.entity) // Еда уже на земле
 		}
 
-		// Обновляем позицию в системе коллизий, если она изменилась
-		if oldPos != food.Position && fs.collider != nil {
-			fs.collider.UpdateObjectPosition(food.ID, food.Position)
+		// Обновляем позицию в системе коллизий и в мире
+		if fs.collider != nil {
+			fs.collider.UpdateObjectPosition(food.id, food.position)
+		}
+		if worldManager := fs.gameTicker.worldManager; worldManager != nil {
+			worldManager.UpdateObjectPosition(food.id, toWorldVector(food.position))
 		}
 	}
 }
 
-// logFoodStats логирует статистику еды
-func (fs *FoodSystem) logFoodStats() {
-	fs.foodMutex.RLock()
-	defer fs.foodMutex.RUnlock()
+// toWorldVector переводит позицию еды в координаты мира
+func toWorldVector(v Vector3) world.Vector3 {
+	return world.Vector3{X: float32(v.X), Y: float32(v.Y), Z: float32(v.Z)}
+}
+
+// groundHeightAt возвращает высоту земли в точке: террейн, если он есть, иначе плоский уровень
+func (fs *FoodSystem) groundHeightAt(x, z float64) float64 {
+	if groundHeight, ok := fs.gameTicker.GroundHeightAt(x, z); ok {
+		return groundHeight
+	}
+	return fs.groundLevel
+}
+
+// LogStats логирует статистику еды (по расписанию StatsLogSystem)
+func (fs *FoodSystem) LogStats() {
+	entities := fs.gameTicker.Entities()
 
 	stats := make(map[FoodType]int)
-	onGround := 0
+	total := 0
+	Each(entities, func(_ Entity, state *FoodState) {
+		stats[state.Type]++
+		total++
+	})
 	falling := 0
-
-	for _, food := range fs.foodItems {
-		stats[food.Type]++
-		if food.IsOnGround {
-			onGround++
-		} else {
-			falling++
-		}
-	}
+	Query2(entities, func(_ Entity, _ *Velocity, _ *FoodState) {
+		falling++
+	})
 
 	var colliderStats int
 	if fs.collider != nil {
@@ -271,57 +410,32 @@ func (fs *FoodSystem) logFoodStats() {
 	}
 
 	fs.logger.Printf("[FoodSystem] Статистика еды: всего %d, падает %d, на земле %d, в коллайдере %d. Типы: обычная %d, средняя %d, большая %d, редкая %d",
-		len(fs.foodItems), falling, onGround, colliderStats,
+		total, falling, total-falling, colliderStats,
 		stats[FoodBasic], stats[FoodMedium], stats[FoodLarge], stats[FoodRare])
 }
 
-// cleanupOldFood удаляет старую еду
-func (fs *FoodSystem) cleanupOldFood() {
+// SetFoodTTL задает время жизни для новой еды
+func (fs *FoodSystem) SetFoodTTL(ttl time.Duration) {
 	fs.foodMutex.Lock()
 	defer fs.foodMutex.Unlock()
 
-	now := time.Now()
-	maxAge := 60 * time.Second // Еда исчезает через 60 секунд
-
-	var toRemove []string
-	for foodID, food := range fs.foodItems {
-		if now.Sub(food.SpawnTime) > maxAge {
-			toRemove = append(toRemove, foodID)
-		}
-	}
-
-	for _, foodID := range toRemove {
-		delete(fs.foodItems, foodID)
-		if fs.collider != nil {
-			fs.collider.RemoveObject(foodID) // Удаляем из spatial grid
-		}
-	}
-
-	if len(toRemove) > 0 {
-		fs.logger.Printf("[FoodSystem] Удалена старая еда: %d объектов", len(toRemove))
-	}
+	fs.foodTTL = ttl
 }
 
-// GetFoodItems возвращает копию всей еды (для сети)
+// GetFoodItems возвращает снимки всей еды (для сети)
 func (fs *FoodSystem) GetFoodItems() map[string]*FoodItem {
-	fs.foodMutex.RLock()
-	defer fs.foodMutex.RUnlock()
+	entities := fs.gameTicker.Entities()
 
 	result := make(map[string]*FoodItem)
-	for id, food := range fs.foodItems {
-		// Создаем копию для безопасности
-		result[id] = &FoodItem{
-			ID:         food.ID,
-			Position:   food.Position,
-			Velocity:   food.Velocity,
-			Radius:     food.Radius,
-			Mass:       food.Mass,
-			Color:      food.Color,
-			Type:       food.Type,
-			SpawnTime:  food.SpawnTime,
-			IsOnGround: food.IsOnGround,
+	Query4(entities, func(_ Entity, state *FoodState, pos *Position, radius *Radius, mass *Mass) {
+		result[state.ID] = newFoodItemSnapshot(state, pos, radius, mass)
+	})
+	Query2(entities, func(_ Entity, velocity *Velocity, state *FoodState) {
+		if food, ok := result[state.ID]; ok {
+			food.Velocity = Vector3(*velocity)
+			food.IsOnGround = false
 		}
-	}
+	})
 
 	return result
 }
@@ -352,20 +466,36 @@ func (fs *FoodSystem) GetPriority() int {
 	return fs.priority
 }
 
-// consumeFood обрабатывает поедание еды игроком
-func (fs *FoodSystem) consumeFood(playerID string, player *Player, food *FoodItem) {
-	// Увеличиваем массу игрока
-	oldRadius := player.Radius
-	player.Score += int64(food.Mass)
+// consumeFood обрабатывает поедание еды игроком: удаляет сущность еды и
+// увеличивает радиус и очки игрока в его компонентах
+func (fs *FoodSystem) consumeFood(playerID string, food *FoodItem) {
+	if id, ok := fs.foodEntity(food.ID); ok {
+		fs.gameTicker.Entities().Destroy(id)
+	}
+	fs.removeFoodObject(food.ID)
 
-	// Формула роста: новый радиус = sqrt(старая_площадь + съеденная_масса)
-	newArea := math.Pi*oldRadius*oldRadius + food.Mass
-	newRadius := math.Sqrt(newArea / math.Pi)
+	player, ok := fs.gameTicker.playerEntity(playerID)
+	if !ok {
+		return
+	}
+	entities := fs.gameTicker.Entities()
 
-	player.Radius = newRadius
-	player.LastSeen = time.Now()
+	// Формула роста: новый радиус = sqrt(старая_площадь + съеденная_масса)
+	var oldRadius, newRadius float64
+	Update(entities, player, func(radius *Radius) {
+		oldRadius = float64(*radius)
+		newRadius = math.Sqrt((math.Pi*oldRadius*oldRadius + food.Mass) / math.Pi)
+		*radius = Radius(newRadius)
+	})
+
+	var score int64
+	Update(entities, player, func(state *PlayerState) {
+		state.Score += int64(food.Mass)
+		state.LastSeen = fs.Now()
+		score = state.Score
+	})
 
 	fs.logger.Printf("[FoodSystem] Игрок %s съел %s (тип %s): радиус %.2f -> %.2f, очки +%.0f (всего %d)",
 		playerID, food.ID, fs.getFoodTypeName(food.Type),
-		oldRadius, newRadius, food.Mass, player.Score)
+		oldRadius, newRadius, food.Mass, score)
 }
@@ -0,0 +1,55 @@
+package game
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+func TestFoodSystem_FoodEntitiesFallAndGetEaten(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	gameTicker := createTestGameTicker()
+
+	foodSystem := NewFoodSystem(gameTicker, logger)
+	collisions := NewCollisionManagerSystem(gameTicker, logger)
+	collisions.SetFoodSystem(foodSystem)
+
+	// Еда в воздухе над плоской землей: у сущности есть Velocity
+	foodSystem.addFood(&FoodItem{
+		ID:       "food_falling",
+		Position: Vector3{X: 0, Y: 3, Z: 0},
+		Radius:   0.5,
+		Mass:     4,
+		Type:     FoodMedium,
+	})
+
+	for i := 0; i < 40; i++ {
+		foodSystem.updateFoodPhysics((50 * time.Millisecond).Seconds())
+	}
+
+	food := foodSystem.GetFood("food_falling")
+	if food == nil || !food.IsOnGround {
+		t.Fatalf("Еда должна упасть на землю: %+v", food)
+	}
+	if food.Position.Y != foodSystem.groundLevel+food.Radius {
+		t.Errorf("Еда должна лежать на земле, Y=%.2f", food.Position.Y)
+	}
+	if entity, _ := foodSystem.foodEntity("food_falling"); Has[Velocity](gameTicker.Entities(), entity) {
+		t.Error("У упавшей еды не должно остаться Velocity")
+	}
+
+	// Игрок на месте еды съедает ее: растут компоненты игрока, сущность еды удаляется
+	gameTicker.AddPlayerWithRadiusAndMass("player", Vector3{X: 0, Y: 1.5, Z: 0}, 2, 10)
+	if err := collisions.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления коллизий: %v", err)
+	}
+
+	if foodSystem.GetFood("food_falling") != nil || foodSystem.foodCount() != 0 {
+		t.Error("Съеденная еда должна быть удалена из хранилища")
+	}
+	player := gameTicker.GetPlayer("player")
+	if player.Score != 4 || player.Radius <= 2 {
+		t.Errorf("Игрок должен вырасти и получить очки: радиус %.2f, очки %d", player.Radius, player.Score)
+	}
+}
@@ -0,0 +1,171 @@
+package game
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultHistoryDuration сколько игрового времени хранит история состояний
+const DefaultHistoryDuration = time.Second
+
+// maxLagCompensation ограничивает откат по задержке клиента: клиент с огромным
+// пингом не должен есть то, чего давно нет
+const maxLagCompensation = 500 * time.Millisecond
+
+// EntitySnapshot положение и размер сущности в прошлом тике
+type EntitySnapshot struct {
+	Position Vector3
+	Radius   float64
+}
+
+// TickSnapshot состояние игроков и еды в конце тика
+type TickSnapshot struct {
+	Tick    uint64
+	Time    time.Time
+	Players map[string]EntitySnapshot
+	Food    map[string]EntitySnapshot
+}
+
+// StateHistory кольцевой буфер снимков последних тиков для компенсации задержки
+type StateHistory struct {
+	mu     sync.RWMutex
+	ring   []*TickSnapshot
+	next   int
+	filled bool
+}
+
+// NewStateHistory создает историю на capacity тиков
+func NewStateHistory(capacity int) *StateHistory {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &StateHistory{ring: make([]*TickSnapshot, capacity)}
+}
+
+// push добавляет снимок, вытесняя самый старый
+func (h *StateHistory) push(snapshot *TickSnapshot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.ring[h.next] = snapshot
+	h.next = (h.next + 1) % len(h.ring)
+	if h.next == 0 {
+		h.filled = true
+	}
+}
+
+// at возвращает снимок тика или ближайший более старый, если тик уже вытеснен
+func (h *StateHistory) at(tick uint64) (*TickSnapshot, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	count := h.next
+	if h.filled {
+		count = len(h.ring)
+	}
+	if count == 0 {
+		return nil, false
+	}
+
+	// Снимки лежат по возрастанию тиков, идем от нового к старому
+	for i := 1; i <= count; i++ {
+		snapshot := h.ring[(h.next-i+len(h.ring))%len(h.ring)]
+		if snapshot.Tick <= tick {
+			return snapshot, true
+		}
+	}
+	return h.ring[(h.next-count+len(h.ring))%len(h.ring)], true
+}
+
+// latest возвращает последний сохраненный снимок
+func (h *StateHistory) latest() (*TickSnapshot, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.next == 0 && !h.filled {
+		return nil, false
+	}
+	return h.ring[(h.next-1+len(h.ring))%len(h.ring)], true
+}
+
+// Capacity возвращает количество хранимых тиков
+func (h *StateHistory) Capacity() int {
+	return len(h.ring)
+}
+
+// SetHistoryDuration задает, сколько игрового времени хранит история состояний.
+// Вызывать до Start.
+func (gt *GameTicker) SetHistoryDuration(d time.Duration) {
+	ticks := int((d + gt.tickDuration - 1) / gt.tickDuration)
+	gt.history = NewStateHistory(ticks + 1)
+}
+
+// recordHistory сохраняет снимок игроков и еды в конце тика
+func (gt *GameTicker) recordHistory(tickTime time.Time) {
+	snapshot := &TickSnapshot{
+		Tick:    gt.tickCount.Load(),
+		Time:    tickTime,
+		Players: make(map[string]EntitySnapshot),
+		Food:    make(map[string]EntitySnapshot),
+	}
+	Query3(gt.entities, func(_ Entity, state *PlayerState, pos *Position, radius *Radius) {
+		snapshot.Players[state.ID] = EntitySnapshot{Position: Vector3(*pos), Radius: float64(*radius)}
+	})
+	Query3(gt.entities, func(_ Entity, state *FoodState, pos *Position, radius *Radius) {
+		snapshot.Food[state.ID] = EntitySnapshot{Position: Vector3(*pos), Radius: float64(*radius)}
+	})
+	gt.history.push(snapshot)
+}
+
+// Rewind возвращает состояние в конце тика. Если тик старше истории, возвращается
+// самый старый сохраненный снимок; false - история пуста.
+func (gt *GameTicker) Rewind(tick uint64) (*TickSnapshot, bool) {
+	return gt.history.at(tick)
+}
+
+// RewindLatency возвращает состояние, которое видел клиент с задержкой rtt:
+// снимок уходит к клиенту за половину rtt, и его реакция возвращается за вторую
+// половину, поэтому откатываемся на rtt (не больше maxLagCompensation)
+func (gt *GameTicker) RewindLatency(rtt time.Duration) (*TickSnapshot, bool) {
+	latest, ok := gt.history.latest()
+	if !ok {
+		return nil, false
+	}
+
+	rtt = min(max(rtt, 0), maxLagCompensation)
+	back := uint64((rtt + gt.tickDuration/2) / gt.tickDuration)
+	if back > latest.Tick {
+		back = latest.Tick
+	}
+	return gt.Rewind(latest.Tick - back)
+}
+
+// SeenBy возвращает состояние, которое видел клиент игрока, по его измеренному RTT.
+// false - игрок не найден или задержка еще не измерена.
+func (gt *GameTicker) SeenBy(playerID string) (*TickSnapshot, bool) {
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return nil, false
+	}
+	state, ok := Get[PlayerState](gt.entities, id)
+	if !ok || state.RTT == 0 {
+		return nil, false
+	}
+	return gt.RewindLatency(state.RTT)
+}
+
+// SetPlayerLatency учитывает замер RTT игрока. Значение сглаживается, чтобы
+// единичный выброс пинга не двигал компенсацию задержки.
+func (gt *GameTicker) SetPlayerLatency(playerID string, rtt time.Duration) {
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return
+	}
+	Update(gt.entities, id, func(state *PlayerState) {
+		if state.RTT == 0 {
+			state.RTT = rtt
+		} else {
+			state.RTT = (state.RTT*7 + rtt) / 8
+		}
+	})
+}
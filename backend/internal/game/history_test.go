@@ -0,0 +1,92 @@
+package game
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+// moverSystem сдвигает игрока на единицу по X каждый тик
+type moverSystem struct {
+	gameTicker *GameTicker
+	playerID   string
+}
+
+func (s *moverSystem) Update(deltaTime time.Duration) error {
+	player := s.gameTicker.GetPlayer(s.playerID)
+	s.gameTicker.UpdatePlayerPosition(s.playerID, Vector3{X: player.Position.X + 1})
+	return nil
+}
+
+func (s *moverSystem) GetName() string  { return "mover" }
+func (s *moverSystem) GetPriority() int { return 1 }
+
+func TestGameTicker_RewindHistory(t *testing.T) {
+	gameTicker := NewGameTicker(20, nil, log.New(io.Discard, "", 0))
+	gameTicker.SetSystemWorkers(1)
+	if _, ok := gameTicker.Rewind(0); ok {
+		t.Error("Пустая история не должна возвращать снимок")
+	}
+
+	gameTicker.AddPlayer("p", Vector3{})
+	gameTicker.RegisterSystem(&moverSystem{gameTicker: gameTicker, playerID: "p"})
+	gameTicker.Pause()
+	gameTicker.Step(30)
+
+	// Секунда при 20 TPS - 21 снимок: тики 10..30
+	snapshot, ok := gameTicker.Rewind(25)
+	if !ok || snapshot.Tick != 25 || snapshot.Players["p"].Position.X != 25 {
+		t.Errorf("Ожидали игрока в X=25 на тике 25, получили %+v", snapshot)
+	}
+	if snapshot, _ := gameTicker.Rewind(3); snapshot.Tick != 10 {
+		t.Errorf("Тик старше истории должен вернуть самый старый снимок 10, получили %d", snapshot.Tick)
+	}
+
+	// RTT 100ms - два тика назад от последнего снимка, огромный RTT ограничен
+	if snapshot, _ := gameTicker.RewindLatency(100 * time.Millisecond); snapshot.Tick != 28 {
+		t.Errorf("RTT 100ms: ожидали тик 28, получили %d", snapshot.Tick)
+	}
+	if snapshot, _ := gameTicker.RewindLatency(time.Minute); snapshot.Tick != 20 {
+		t.Errorf("Откат должен быть ограничен %v, получили тик %d", maxLagCompensation, snapshot.Tick)
+	}
+
+	// Состояние, которое видел игрок, определяется его сглаженным RTT
+	if _, ok := gameTicker.SeenBy("p"); ok {
+		t.Error("Без замера задержки SeenBy не должен откатывать состояние")
+	}
+	gameTicker.SetPlayerLatency("p", 150*time.Millisecond)
+	if snapshot, ok := gameTicker.SeenBy("p"); !ok || snapshot.Tick != 27 {
+		t.Errorf("RTT 150ms: ожидали тик 27, получили %+v", snapshot)
+	}
+}
+
+func TestSimpleFoodSystem_LagCompensation(t *testing.T) {
+	gameTicker := NewGameTicker(20, nil, log.New(io.Discard, "", 0))
+	gameTicker.Pause()
+	foodSystem := NewSimpleFoodSystem(gameTicker, log.New(io.Discard, "", 0))
+	foodSystem.SetLagCompensation(true)
+
+	gameTicker.AddPlayerWithRadiusAndMass("p", Vector3{X: 1000, Z: 1000}, 5, 10)
+	gameTicker.SetPlayerLatency("p", 200*time.Millisecond)
+	gameTicker.Step(10)
+
+	// Еда появилась прямо под игроком, но его клиент увидит ее только через RTT
+	foodSystem.addFood(&SimpleFood{ID: "food_near", X: 1000, Z: 1000, Radius: 1, Mass: 1})
+	foodSystem.checkCollisions()
+	if foodSystem.foodCount() != 1 {
+		t.Fatal("Игрок съел еду, которую его клиент еще не видел")
+	}
+
+	gameTicker.Step(2)
+	foodSystem.checkCollisions()
+	if foodSystem.foodCount() != 1 {
+		t.Fatal("Еда съедена раньше, чем клиент мог ее увидеть")
+	}
+
+	gameTicker.Step(3)
+	foodSystem.checkCollisions()
+	if foodSystem.foodCount() != 0 {
+		t.Error("Еда, которую видел клиент игрока, должна быть съедена")
+	}
+}
@@ -0,0 +1,336 @@
+package game
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "x-cells/backend/internal/physics/generated"
+)
+
+// InputPhysicsClient часть физического клиента, нужная системе ввода
+type InputPhysicsClient interface {
+	ApplyImpulse(ctx context.Context, req *pb.ApplyImpulseRequest, opts ...grpc.CallOption) (*pb.ApplyImpulseResponse, error)
+}
+
+const (
+	DefaultInputsPerTick  = 4    // Команд одного игрока за тик
+	DefaultInputQueueSize = 64   // Команд в очереди игрока; старые вытесняются
+	DefaultInputHistory   = 2048 // Примененных команд в журнале
+)
+
+// InputCommand команда игрока, ожидающая применения в тике
+type InputCommand struct {
+	PlayerID   string
+	Seq        uint64 // Порядковый номер команды клиента
+	Cmd        string
+	Impulse    Vector3 // Импульс, рассчитанный из команды при получении
+	ClientTime int64
+	ReceivedAt time.Time
+}
+
+// AppliedInput запись журнала: какая команда применена в каком тике
+type AppliedInput struct {
+	Tick       uint64    `json:"tick"`
+	PlayerID   string    `json:"player_id"`
+	Seq        uint64    `json:"seq"`
+	Cmd        string    `json:"cmd"`
+	Impulse    Vector3   `json:"impulse"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// InputStats счетчики очереди ввода
+type InputStats struct {
+	Queued     int    `json:"queued"`     // Команд в очередях сейчас
+	Applied    uint64 `json:"applied"`    // Применено
+	Deferred   uint64 `json:"deferred"`   // Перенесено на следующий тик из-за лимита
+	Dropped    uint64 `json:"dropped"`    // Вытеснено из переполненной очереди
+	Duplicates uint64 `json:"duplicates"` // Отброшено как повтор или устаревшая команда
+}
+
+// playerInputs очередь команд игрока
+type playerInputs struct {
+	commands []InputCommand
+	lastSeq  uint64  // Последний принятый номер команды
+	force    Vector3 // Сила контроллера, действует каждый тик до следующего изменения
+}
+
+// tickInput ввод игрока, применяемый в одном тике
+type tickInput struct {
+	playerID string
+	commands []InputCommand
+	force    Vector3 // Импульс силы контроллера за тик
+}
+
+// InputSystem применяет команды игроков в начале тика. Команды приходят из
+// горутин WebSocket-соединений в любой момент, но попадают в физику только
+// здесь, в порядке номеров команд и не больше inputsPerTick за тик на игрока.
+// Сила контроллера игрока тоже применяется здесь: каждый тик как импульс за deltaTime.
+// Журнал примененных команд позволяет воспроизвести ввод по тикам.
+type InputSystem struct {
+	SystemClock
+	SystemContext
+
+	name       string
+	priority   int
+	physics    InputPhysicsClient
+	gameTicker *GameTicker
+	logger     *log.Logger
+
+	inputsPerTick int
+	queueSize     int
+
+	mu      sync.Mutex
+	players map[string]*playerInputs
+	stats   InputStats
+
+	history     []AppliedInput // Кольцевой буфер журнала
+	historyNext int
+	historyFull bool
+}
+
+// NewInputSystem создает систему ввода. Очереди игроков удаляются по событию PlayerLeft.
+func NewInputSystem(physics InputPhysicsClient, gameTicker *GameTicker, logger *log.Logger) *InputSystem {
+	if logger == nil {
+		logger = log.Default()
+	}
+	is := &InputSystem{
+		name:          "InputSystem",
+		priority:      1, // Ввод применяется раньше всех систем тика
+		physics:       physics,
+		gameTicker:    gameTicker,
+		logger:        logger,
+		inputsPerTick: DefaultInputsPerTick,
+		queueSize:     DefaultInputQueueSize,
+		players:       make(map[string]*playerInputs),
+		history:       make([]AppliedInput, DefaultInputHistory),
+	}
+
+	Subscribe(gameTicker.Events(), func(e PlayerLeft) {
+		is.mu.Lock()
+		defer is.mu.Unlock()
+		delete(is.players, e.PlayerID)
+	})
+	return is
+}
+
+// SetLimits задает лимит команд игрока за тик и размер очереди игрока
+func (is *InputSystem) SetLimits(perTick, queueSize int) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	if perTick > 0 {
+		is.inputsPerTick = perTick
+	}
+	if queueSize > 0 {
+		is.queueSize = queueSize
+	}
+}
+
+// EnqueueInput ставит команду игрока в очередь. seq - номер команды клиента;
+// если клиент его не передал (0), команда получает следующий номер после последней.
+// Повторы и команды старше уже принятых отбрасываются, тогда возвращается false.
+func (is *InputSystem) EnqueueInput(playerID string, seq uint64, cmd string, clientTime int64, impulse *pb.Vector3) bool {
+	if impulse == nil {
+		return false
+	}
+
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	queue, ok := is.players[playerID]
+	if !ok {
+		queue = &playerInputs{}
+		is.players[playerID] = queue
+	}
+
+	if seq == 0 {
+		seq = queue.lastSeq + 1
+	} else if seq <= queue.lastSeq {
+		is.stats.Duplicates++
+		return false
+	}
+	queue.lastSeq = seq
+
+	// Переполненная очередь теряет самые старые команды: свежий ввод важнее
+	if len(queue.commands) >= is.queueSize {
+		dropped := len(queue.commands) - is.queueSize + 1
+		queue.commands = append(queue.commands[:0], queue.commands[dropped:]...)
+		is.stats.Dropped += uint64(dropped)
+	}
+
+	queue.commands = append(queue.commands, InputCommand{
+		PlayerID:   playerID,
+		Seq:        seq,
+		Cmd:        cmd,
+		Impulse:    Vector3{X: float64(impulse.X), Y: float64(impulse.Y), Z: float64(impulse.Z)},
+		ClientTime: clientTime,
+		ReceivedAt: is.Now(),
+	})
+	return true
+}
+
+// SetControllerForce задает силу контроллера игрока. Сила действует каждый тик,
+// пока ее не заменят; nil или нулевая сила снимает ее.
+func (is *InputSystem) SetControllerForce(playerID string, force *pb.Vector3) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	queue, ok := is.players[playerID]
+	if !ok {
+		if force == nil {
+			return
+		}
+		queue = &playerInputs{}
+		is.players[playerID] = queue
+	}
+
+	queue.force = Vector3{}
+	if force != nil {
+		queue.force = Vector3{X: float64(force.X), Y: float64(force.Y), Z: float64(force.Z)}
+	}
+}
+
+// Update применяет накопившиеся команды и силу контроллеров: игроки обходятся
+// в порядке ID, весь ввод одного игрока за тик складывается в один вызов физики
+func (is *InputSystem) Update(deltaTime time.Duration) error {
+	tick := is.gameTicker.GetTickCount()
+
+	for _, input := range is.takeInputs(deltaTime) {
+		total := input.force
+		for _, command := range input.commands {
+			total.X += command.Impulse.X
+			total.Y += command.Impulse.Y
+			total.Z += command.Impulse.Z
+		}
+
+		playerID := input.playerID
+		_, err := is.physics.ApplyImpulse(is.Context(), &pb.ApplyImpulseRequest{
+			Id:      playerID,
+			Impulse: &pb.Vector3{X: float32(total.X), Y: float32(total.Y), Z: float32(total.Z)},
+		})
+		if err != nil {
+			// Команды считаются использованными: повтор в следующем тике исказил бы ввод
+			is.logger.Printf("[InputSystem] Ошибка применения ввода игрока %s в тике %d: %v", playerID, tick, err)
+			continue
+		}
+
+		is.record(tick, input.commands)
+		for _, command := range input.commands {
+			is.gameTicker.Events().Publish(is.name, InputApplied{
+				PlayerID:   command.PlayerID,
+				Seq:        command.Seq,
+				Cmd:        command.Cmd,
+				ClientTime: command.ClientTime,
+				Tick:       tick,
+			})
+		}
+	}
+	return nil
+}
+
+// takeInputs забирает из очередей до inputsPerTick команд каждого игрока и
+// импульс силы его контроллера за deltaTime
+func (is *InputSystem) takeInputs(deltaTime time.Duration) []tickInput {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	ids := make([]string, 0, len(is.players))
+	for id, queue := range is.players {
+		if len(queue.commands) > 0 || queue.force != (Vector3{}) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	seconds := deltaTime.Seconds()
+	inputs := make([]tickInput, 0, len(ids))
+	for _, id := range ids {
+		queue := is.players[id]
+		n := min(len(queue.commands), is.inputsPerTick)
+		inputs = append(inputs, tickInput{
+			playerID: id,
+			commands: append([]InputCommand(nil), queue.commands[:n]...),
+			force:    Vector3{X: queue.force.X * seconds, Y: queue.force.Y * seconds, Z: queue.force.Z * seconds},
+		})
+		queue.commands = append(queue.commands[:0], queue.commands[n:]...)
+		is.stats.Deferred += uint64(len(queue.commands))
+	}
+	return inputs
+}
+
+// record добавляет примененные команды в журнал
+func (is *InputSystem) record(tick uint64, batch []InputCommand) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	for _, command := range batch {
+		is.history[is.historyNext] = AppliedInput{
+			Tick:       tick,
+			PlayerID:   command.PlayerID,
+			Seq:        command.Seq,
+			Cmd:        command.Cmd,
+			Impulse:    command.Impulse,
+			ReceivedAt: command.ReceivedAt,
+		}
+		is.historyNext = (is.historyNext + 1) % len(is.history)
+		if is.historyNext == 0 {
+			is.historyFull = true
+		}
+		is.stats.Applied++
+	}
+}
+
+// History возвращает журнал примененных команд начиная с тика sinceTick,
+// от старых к новым. Хранятся последние DefaultInputHistory команд.
+func (is *InputSystem) History(sinceTick uint64) []AppliedInput {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	start, count := 0, is.historyNext
+	if is.historyFull {
+		start, count = is.historyNext, len(is.history)
+	}
+
+	var result []AppliedInput
+	for i := 0; i < count; i++ {
+		entry := is.history[(start+i)%len(is.history)]
+		if entry.Tick >= sinceTick {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// Stats возвращает счетчики очереди ввода
+func (is *InputSystem) Stats() InputStats {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+
+	stats := is.stats
+	for _, queue := range is.players {
+		stats.Queued += len(queue.commands)
+	}
+	return stats
+}
+
+// GetName возвращает имя системы
+func (is *InputSystem) GetName() string {
+	return is.name
+}
+
+// GetPriority возвращает приоритет системы
+func (is *InputSystem) GetPriority() int {
+	return is.priority
+}
+
+// Access объявляет ресурсы системы для планировщика
+func (is *InputSystem) Access() SystemAccess {
+	return SystemAccess{
+		Writes: []string{ResourcePhysics},
+	}
+}
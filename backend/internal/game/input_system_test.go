@@ -0,0 +1,137 @@
+package game
+
+import (
+	"context"
+	"io"
+	"log"
+	"math"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "x-cells/backend/internal/physics/generated"
+)
+
+type fakeInputPhysics struct {
+	requests []*pb.ApplyImpulseRequest
+}
+
+func (f *fakeInputPhysics) ApplyImpulse(ctx context.Context, req *pb.ApplyImpulseRequest, opts ...grpc.CallOption) (*pb.ApplyImpulseResponse, error) {
+	f.requests = append(f.requests, req)
+	return &pb.ApplyImpulseResponse{Status: "OK"}, nil
+}
+
+func TestInputSystem_AppliesQueuedInputPerTick(t *testing.T) {
+	gameTicker := NewGameTicker(20, nil, log.New(io.Discard, "", 0))
+	gameTicker.SetSystemWorkers(1)
+	physics := &fakeInputPhysics{}
+	inputSystem := NewInputSystem(physics, gameTicker, log.New(io.Discard, "", 0))
+	inputSystem.SetLimits(2, 0)
+	gameTicker.RegisterSystem(inputSystem)
+
+	var acks []InputApplied
+	Subscribe(gameTicker.Events(), func(e InputApplied) { acks = append(acks, e) })
+
+	// Команды приходят из горутин соединений, но до тика в физику ничего не попадает
+	var wg sync.WaitGroup
+	for _, player := range []string{"b", "a"} {
+		wg.Add(1)
+		go func(player string) {
+			defer wg.Done()
+			for i := 0; i < 3; i++ {
+				inputSystem.EnqueueInput(player, 0, "LEFT", int64(i), &pb.Vector3{X: -15})
+			}
+		}(player)
+	}
+	wg.Wait()
+	if len(physics.requests) != 0 {
+		t.Fatalf("Импульс применен вне тика: %d вызовов", len(physics.requests))
+	}
+
+	// Повтор и устаревший номер отбрасываются, пропуск номеров допустим
+	if inputSystem.EnqueueInput("a", 3, "UP", 0, &pb.Vector3{Z: -15}) {
+		t.Error("Повтор команды с номером 3 должен быть отброшен")
+	}
+	if !inputSystem.EnqueueInput("a", 10, "UP", 0, &pb.Vector3{Z: -15}) {
+		t.Error("Команда с номером 10 должна быть принята")
+	}
+
+	gameTicker.Pause()
+	gameTicker.Step(1)
+
+	// Игроки в порядке ID, не больше двух команд за тик, импульсы сложены
+	if len(physics.requests) != 2 || physics.requests[0].Id != "a" || physics.requests[1].Id != "b" {
+		t.Fatalf("Ожидали по одному вызову для a и b, получили %v", physics.requests)
+	}
+	if x := physics.requests[0].Impulse.X; x != -30 {
+		t.Errorf("Ожидали сумму импульсов двух команд -30, получили %v", x)
+	}
+	if stats := inputSystem.Stats(); stats.Queued != 3 || stats.Duplicates != 1 || stats.Applied != 4 {
+		t.Errorf("Неожиданные счетчики после первого тика: %+v", stats)
+	}
+
+	gameTicker.Step(2)
+
+	history := inputSystem.History(0)
+	if len(history) != 7 {
+		t.Fatalf("Ожидали 7 команд в журнале, получили %d", len(history))
+	}
+	last := history[len(history)-1]
+	if last.Tick != 2 || last.PlayerID != "b" || last.Seq != 3 {
+		t.Errorf("Последняя команда журнала: ожидали b#3 в тике 2, получили %+v", last)
+	}
+	for _, entry := range inputSystem.History(2) {
+		if entry.Tick < 2 {
+			t.Errorf("History(2) вернул команду тика %d", entry.Tick)
+		}
+	}
+	if entry := history[4]; entry.PlayerID != "a" || entry.Seq != 3 || entry.Tick != 2 {
+		t.Errorf("Третья команда игрока a должна быть перенесена во второй тик, получили %+v", entry)
+	}
+	if len(acks) != 7 || acks[0].Tick != 1 || acks[0].ClientTime != 0 {
+		t.Errorf("Ожидали подтверждение каждой команды с номером тика, получили %+v", acks)
+	}
+
+	// Ушедший игрок теряет очередь
+	gameTicker.AddPlayer("a", Vector3{})
+	inputSystem.EnqueueInput("a", 0, "LEFT", 0, &pb.Vector3{X: -15})
+	gameTicker.RemovePlayer("a")
+	gameTicker.Events().Dispatch()
+	if stats := inputSystem.Stats(); stats.Queued != 0 {
+		t.Errorf("Очередь ушедшего игрока не удалена: %+v", stats)
+	}
+}
+
+func TestInputSystem_AppliesControllerForceEachTick(t *testing.T) {
+	gameTicker := NewGameTicker(20, nil, log.New(io.Discard, "", 0))
+	gameTicker.SetSystemWorkers(1)
+	physics := &fakeInputPhysics{}
+	inputSystem := NewInputSystem(physics, gameTicker, log.New(io.Discard, "", 0))
+	gameTicker.RegisterSystem(inputSystem)
+	gameTicker.Pause()
+
+	// Сила действует каждый тик как импульс за deltaTime и складывается с командами
+	inputSystem.SetControllerForce("a", &pb.Vector3{X: 10})
+	inputSystem.EnqueueInput("a", 0, "UP", 0, &pb.Vector3{Z: -15})
+	gameTicker.Step(2)
+
+	if len(physics.requests) != 2 {
+		t.Fatalf("Ожидали вызов физики в каждом тике, получили %d", len(physics.requests))
+	}
+	if impulse := physics.requests[0].Impulse; math.Abs(float64(impulse.X)-0.5) > 1e-6 || impulse.Z != -15 {
+		t.Errorf("Ожидали импульс силы 0.5 за тик 50ms вместе с командой, получили %+v", impulse)
+	}
+	if impulse := physics.requests[1].Impulse; math.Abs(float64(impulse.X)-0.5) > 1e-6 || impulse.Z != 0 {
+		t.Errorf("Во втором тике ожидали только силу контроллера, получили %+v", impulse)
+	}
+	if history := inputSystem.History(0); len(history) != 1 {
+		t.Errorf("Сила контроллера не команда и не попадает в журнал, записей %d", len(history))
+	}
+
+	inputSystem.SetControllerForce("a", nil)
+	gameTicker.Step(1)
+	if len(physics.requests) != 2 {
+		t.Errorf("Снятая сила не должна применяться, вызовов %d", len(physics.requests))
+	}
+}
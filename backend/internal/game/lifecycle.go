@@ -0,0 +1,117 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultShutdownTimeout сколько Stop ждет завершения тика и Shutdown систем
+const DefaultShutdownTimeout = 10 * time.Second
+
+// Initializer реализуют системы, которым нужна подготовка перед первым тиком:
+// проверка ресурсов, загрузка данных. Ошибка Init не дает запустить цикл.
+type Initializer interface {
+	Init(ctx context.Context) error
+}
+
+// Shutdowner реализуют системы, которым нужно освободить ресурсы или сохранить
+// состояние при остановке. Shutdown вызывается после последнего тика.
+type Shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+// initSystems инициализирует системы в порядке приоритета. При ошибке уже
+// инициализированные системы останавливаются в обратном порядке.
+func (gt *GameTicker) initSystems(ctx context.Context) error {
+	gt.systemsMutex.RLock()
+	systems := append([]TickSystem(nil), gt.systems...)
+	gt.systemsMutex.RUnlock()
+
+	for _, system := range systems {
+		if err := gt.initSystem(ctx, system); err != nil {
+			if shutdownErr := gt.shutdownSystems(ctx); shutdownErr != nil {
+				gt.logger.Printf("[GameTicker] ОШИБКА остановки систем после неудачного запуска: %v", shutdownErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// initSystem вызывает Init системы и отмечает ее инициализированной
+func (gt *GameTicker) initSystem(ctx context.Context, system TickSystem) error {
+	if initializer, ok := system.(Initializer); ok {
+		if err := initializer.Init(ctx); err != nil {
+			return fmt.Errorf("инициализация системы %s: %w", system.GetName(), err)
+		}
+	}
+
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+	if state, ok := gt.schedules[system.GetName()]; ok {
+		state.initialized = true
+	}
+	return nil
+}
+
+// shutdownSystems останавливает инициализированные системы в порядке, обратном
+// приоритету: системы, выполнявшиеся последними (например, автосохранение),
+// останавливаются первыми, пока остальные еще не освободили ресурсы
+func (gt *GameTicker) shutdownSystems(ctx context.Context) error {
+	gt.systemsMutex.Lock()
+	var systems []TickSystem
+	for _, system := range gt.systems {
+		if state := gt.schedules[system.GetName()]; state.initialized {
+			state.initialized = false
+			systems = append(systems, system)
+		}
+	}
+	gt.systemsMutex.Unlock()
+
+	var errs []error
+	for i := len(systems) - 1; i >= 0; i-- {
+		if err := gt.shutdownSystem(ctx, systems[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (gt *GameTicker) shutdownSystem(ctx context.Context, system TickSystem) error {
+	shutdowner, ok := system.(Shutdowner)
+	if !ok {
+		return nil
+	}
+	if err := shutdowner.Shutdown(ctx); err != nil {
+		return fmt.Errorf("остановка системы %s: %w", system.GetName(), err)
+	}
+	return nil
+}
+
+// Shutdown останавливает игровой цикл: дожидается завершения текущего тика
+// и вызывает Shutdown систем. Если тик не завершился до отмены ctx, системы
+// все равно останавливаются, чтобы сохранить состояние.
+func (gt *GameTicker) Shutdown(ctx context.Context) error {
+	if !gt.isRunning.Load() {
+		return nil
+	}
+
+	gt.logger.Printf("[GameTicker] Остановка игрового цикла x-cells (выполнено тиков: %d)", gt.GetTickCount())
+
+	gt.cancel()
+
+	var errs []error
+	select {
+	case <-gt.loopDone:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("текущий тик не завершился: %w", ctx.Err()))
+	}
+	gt.isRunning.Store(false)
+
+	if err := gt.shutdownSystems(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
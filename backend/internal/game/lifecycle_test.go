@@ -0,0 +1,150 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lifecycleSystem записывает вызовы Init и Shutdown в общий журнал
+type lifecycleSystem struct {
+	declaredSystem
+	journal *lifecycleJournal
+	initErr error
+}
+
+type lifecycleJournal struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (j *lifecycleJournal) add(entry string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, entry)
+}
+
+func (j *lifecycleJournal) list() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]string(nil), j.entries...)
+}
+
+func (s *lifecycleSystem) Init(ctx context.Context) error {
+	s.journal.add("init " + s.name)
+	return s.initErr
+}
+
+func (s *lifecycleSystem) Shutdown(ctx context.Context) error {
+	s.journal.add("shutdown " + s.name)
+	return nil
+}
+
+func newLifecycleSystem(name string, priority int, journal *lifecycleJournal) *lifecycleSystem {
+	return &lifecycleSystem{declaredSystem: declaredSystem{name: name, priority: priority}, journal: journal}
+}
+
+func TestGameTicker_LifecycleHooks(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(100, nil, logger)
+	journal := &lifecycleJournal{}
+
+	gameTicker.RegisterSystem(newLifecycleSystem("save", 1000, journal))
+	gameTicker.RegisterSystem(newLifecycleSystem("physics", 10, journal))
+
+	if err := gameTicker.Start(); err != nil {
+		t.Fatalf("Ошибка запуска: %v", err)
+	}
+
+	// Добавленная на ходу система инициализируется сразу, удаленная - останавливается
+	gameTicker.RegisterSystem(newLifecycleSystem("late", 50, journal))
+	gameTicker.RegisterSystem(newLifecycleSystem("removed", 60, journal))
+	if err := gameTicker.UnregisterSystem("removed"); err != nil {
+		t.Fatalf("Ошибка удаления системы: %v", err)
+	}
+
+	gameTicker.Stop()
+
+	want := []string{
+		"init physics", "init save",
+		"init late", "init removed", "shutdown removed",
+		"shutdown save", "shutdown late", "shutdown physics",
+	}
+	if got := journal.list(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ожидали %v, получили %v", want, got)
+	}
+}
+
+func TestGameTicker_StartFailsOnInitError(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(100, nil, logger)
+	journal := &lifecycleJournal{}
+
+	broken := newLifecycleSystem("broken", 2, journal)
+	broken.initErr = errors.New("нет ресурса")
+	gameTicker.RegisterSystem(newLifecycleSystem("first", 1, journal))
+	gameTicker.RegisterSystem(broken)
+	gameTicker.RegisterSystem(newLifecycleSystem("never", 3, journal))
+
+	if err := gameTicker.Start(); err == nil {
+		t.Fatal("Ожидали ошибку запуска")
+	}
+	if gameTicker.GetStats()["is_running"].(bool) {
+		t.Error("Цикл не должен запуститься")
+	}
+
+	want := []string{"init first", "init broken", "shutdown first"}
+	if got := journal.list(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ожидали %v, получили %v", want, got)
+	}
+}
+
+func TestGameTicker_ShutdownWaitsForTick(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(100, nil, logger)
+	journal := &lifecycleJournal{}
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	slow := newLifecycleSystem("slow", 1, journal)
+	slow.update = func() {
+		once.Do(func() {
+			close(entered)
+			<-release
+			journal.add("tick finished")
+		})
+	}
+	gameTicker.RegisterSystem(slow)
+
+	if err := gameTicker.Start(); err != nil {
+		t.Fatalf("Ошибка запуска: %v", err)
+	}
+	<-entered
+
+	done := make(chan error, 1)
+	go func() {
+		done <- gameTicker.Shutdown(context.Background())
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Shutdown вернулся, не дождавшись текущего тика")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Ошибка остановки: %v", err)
+	}
+
+	want := []string{"init slow", "tick finished", "shutdown slow"}
+	if got := journal.list(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ожидали %v, получили %v", want, got)
+	}
+}
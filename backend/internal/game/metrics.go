@@ -0,0 +1,82 @@
+package game
+
+import (
+	"sort"
+
+	"x-cells/backend/internal/metrics"
+)
+
+// WriteMetrics пишет метрики игрового цикла и систем в формате Prometheus
+func (gt *GameTicker) WriteMetrics(e *metrics.Exposition) {
+	pm := gt.perfMonitor
+
+	e.Header("xcells_tick_duration_seconds", "histogram", "Длительность выполнения тика")
+	e.Histogram("xcells_tick_duration_seconds", pm.tickDurations.Snapshot())
+	e.HistogramVec("xcells_system_duration_seconds", "Длительность выполнения системы", "system", pm.systemDurations)
+
+	e.Header("xcells_system_errors_total", "counter", "Ошибки и паники систем")
+	for _, system := range pm.errorCounts() {
+		e.Sample("xcells_system_errors_total", float64(system.Errors), metrics.Label{Name: "system", Value: system.Name})
+	}
+
+	e.Header("xcells_watchdog_alerts_total", "counter", "Оповещения сторожа систем по видам")
+	totals := pm.alertTotals()
+	kinds := make([]string, 0, len(totals))
+	for kind := range totals {
+		kinds = append(kinds, string(kind))
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		e.Sample("xcells_watchdog_alerts_total", float64(totals[AlertKind(kind)]), metrics.Label{Name: "kind", Value: kind})
+	}
+
+	e.Header("xcells_system_quarantined", "gauge", "Система в карантине сторожа (1) или нет (0)")
+	for _, info := range gt.ListSystems() {
+		quarantined := 0.0
+		if info.QuarantinedUntil != nil {
+			quarantined = 1
+		}
+		e.Sample("xcells_system_quarantined", quarantined, metrics.Label{Name: "system", Value: info.Name})
+	}
+
+	pm.mutex.RLock()
+	criticalPath := pm.lastCriticalPath
+	pm.mutex.RUnlock()
+
+	paused := 0.0
+	if gt.IsPaused() {
+		paused = 1
+	}
+
+	e.Counter("xcells_ticks_total", "Выполненные тики", float64(gt.GetTickCount()))
+	e.Counter("xcells_skipped_ticks_total", "Пропущенные из-за отставания тики", float64(gt.skippedTicks.Load()))
+	e.Gauge("xcells_tick_critical_path_seconds", "Критический путь систем последнего тика", criticalPath.Seconds())
+	e.Gauge("xcells_ticker_paused", "Игровой цикл на паузе (1) или работает (0)", paused)
+
+	e.Gauge("xcells_players", "Активные игроки", float64(Count[PlayerState](gt.entities)))
+}
+
+// WriteMetrics пишет счетчики очереди ввода в формате Prometheus
+func (is *InputSystem) WriteMetrics(e *metrics.Exposition) {
+	stats := is.Stats()
+
+	e.Gauge("xcells_input_queued", "Команды игроков в очередях ввода", float64(stats.Queued))
+	e.Header("xcells_input_commands_total", "counter", "Команды игроков по результату")
+	e.Sample("xcells_input_commands_total", float64(stats.Applied), metrics.Label{Name: "result", Value: "applied"})
+	e.Sample("xcells_input_commands_total", float64(stats.Dropped), metrics.Label{Name: "result", Value: "dropped"})
+	e.Sample("xcells_input_commands_total", float64(stats.Duplicates), metrics.Label{Name: "result", Value: "duplicate"})
+	e.Counter("xcells_input_deferred_total", "Переносы команд на следующий тик из-за лимита", float64(stats.Deferred))
+}
+
+// errorCounts возвращает счетчики ошибок систем в порядке имен
+func (pm *PerformanceMonitor) errorCounts() []SystemMetrics {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	result := make([]SystemMetrics, 0, len(pm.systemMetrics))
+	for name, m := range pm.systemMetrics {
+		result = append(result, SystemMetrics{Name: name, Errors: m.Errors})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
@@ -0,0 +1,184 @@
+package game
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"x-cells/backend/internal/world"
+)
+
+// PlayerEatConfig условия, при которых один игрок съедает другого
+type PlayerEatConfig struct {
+	// MinOverlap доля перекрытия меньшего игрока: 0 - шары касаются, 0.5 - центр
+	// меньшего шара на поверхности большего, 1 - меньший целиком внутри
+	MinOverlap float64
+	// MinMassAdvantage во сколько раз (сверх единицы) съедающий должен быть массивнее:
+	// 0.25 - на 25% тяжелее жертвы
+	MinMassAdvantage float64
+}
+
+// DefaultPlayerEatConfig возвращает условия по умолчанию
+func DefaultPlayerEatConfig() PlayerEatConfig {
+	return PlayerEatConfig{
+		MinOverlap:       0.5,
+		MinMassAdvantage: 0.25,
+	}
+}
+
+// PlayerEatSystem проверяет перекрытия игроков: более массивный игрок поглощает
+// массу меньшего, а съеденный игрок удаляется из мира и из Bullet
+type PlayerEatSystem struct {
+	SystemContext
+
+	name         string
+	priority     int
+	config       PlayerEatConfig
+	gameTicker   *GameTicker
+	worldManager *world.Manager
+	logger       *log.Logger
+}
+
+// eatCandidate игрок в момент проверки перекрытий
+type eatCandidate struct {
+	id     string
+	pos    Position
+	radius float64
+	mass   float64
+}
+
+// NewPlayerEatSystem создает систему поедания игроков
+func NewPlayerEatSystem(gameTicker *GameTicker, worldManager *world.Manager, logger *log.Logger) *PlayerEatSystem {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &PlayerEatSystem{
+		name:         "PlayerEatSystem",
+		priority:     27, // После еды, до контроля границ
+		config:       DefaultPlayerEatConfig(),
+		gameTicker:   gameTicker,
+		worldManager: worldManager,
+		logger:       logger,
+	}
+}
+
+// SetConfig задает условия поедания
+func (pes *PlayerEatSystem) SetConfig(config PlayerEatConfig) {
+	pes.config = config
+}
+
+// Update ищет пары игроков, в которых один может съесть другого
+func (pes *PlayerEatSystem) Update(deltaTime time.Duration) error {
+	var players []eatCandidate
+	Query4(pes.gameTicker.Entities(), func(_ Entity, state *PlayerState, pos *Position, radius *Radius, mass *Mass) {
+		players = append(players, eatCandidate{id: state.ID, pos: *pos, radius: float64(*radius), mass: float64(*mass)})
+	})
+	if len(players) < 2 {
+		return nil
+	}
+
+	// Первыми едят самые массивные, при равной массе - по ID, чтобы исход не зависел
+	// от порядка обхода хранилища
+	sort.Slice(players, func(i, j int) bool {
+		if players[i].mass != players[j].mass {
+			return players[i].mass > players[j].mass
+		}
+		return players[i].id < players[j].id
+	})
+
+	// Масса растет только после тика: за тик игрок съедает всех, кого мог съесть
+	// в начале тика, а съеденный игрок уже никого не ест
+	eaten := make(map[string]bool)
+	for i, eater := range players {
+		if eaten[eater.id] {
+			continue
+		}
+		for _, victim := range players[i+1:] {
+			if eaten[victim.id] || !pes.canEat(eater, victim) {
+				continue
+			}
+			eaten[victim.id] = true
+			pes.eat(eater, victim)
+		}
+	}
+
+	return nil
+}
+
+// canEat проверяет перевес массы и перекрытие шаров
+func (pes *PlayerEatSystem) canEat(eater, victim eatCandidate) bool {
+	if victim.radius <= 0 || eater.mass < victim.mass*(1+pes.config.MinMassAdvantage) {
+		return false
+	}
+
+	dx := eater.pos.X - victim.pos.X
+	dy := eater.pos.Y - victim.pos.Y
+	dz := eater.pos.Z - victim.pos.Z
+	distance := math.Sqrt(dx*dx + dy*dy + dz*dz)
+
+	overlap := (eater.radius + victim.radius - distance) / (2 * victim.radius)
+	return overlap >= pes.config.MinOverlap
+}
+
+// eat передает массу жертвы съедающему и удаляет жертву из игры
+func (pes *PlayerEatSystem) eat(eater, victim eatCandidate) {
+	pes.logger.Printf("[PlayerEatSystem] Игрок %s (масса %.1f) съел игрока %s (масса %.1f)",
+		eater.id, eater.mass, victim.id, victim.mass)
+
+	pes.gameTicker.UpdatePlayerMass(pes.Context(), eater.id, victim.mass)
+	pes.removeObject(victim.id)
+	pes.gameTicker.RemovePlayer(victim.id)
+
+	events := pes.gameTicker.Events()
+	events.Publish(pes.name, PlayerEaten{EaterID: eater.id, VictimID: victim.id, MassGain: victim.mass})
+	events.Publish(pes.name, PlayerDied{PlayerID: victim.id, KillerID: eater.id})
+}
+
+// removeObject удаляет объект игрока из мира и из Bullet
+func (pes *PlayerEatSystem) removeObject(playerID string) {
+	if pes.worldManager == nil {
+		return
+	}
+
+	obj, ok := pes.worldManager.GetWorldObject(playerID)
+	if !ok {
+		for _, candidate := range pes.worldManager.GetByKind(world.KindPlayer) {
+			if candidate.OwnerID == playerID {
+				obj, ok = candidate, true
+				break
+			}
+		}
+	}
+	if !ok {
+		pes.logger.Printf("[PlayerEatSystem] Объект игрока %s не найден в мире", playerID)
+		return
+	}
+
+	if factory := pes.worldManager.GetFactory(); factory != nil {
+		ctx, cancel := context.WithTimeout(pes.Context(), DespawnTimeout)
+		defer cancel()
+		if err := factory.RemoveObject(ctx, obj); err != nil {
+			pes.logger.Printf("[PlayerEatSystem] Ошибка удаления объекта %s из Bullet: %v", obj.ID, err)
+		}
+	} else {
+		pes.worldManager.RemoveObject(obj.ID)
+	}
+}
+
+// GetName возвращает имя системы
+func (pes *PlayerEatSystem) GetName() string {
+	return pes.name
+}
+
+// GetPriority возвращает приоритет системы
+func (pes *PlayerEatSystem) GetPriority() int {
+	return pes.priority
+}
+
+// Access объявляет ресурсы, с которыми работает система
+func (pes *PlayerEatSystem) Access() SystemAccess {
+	return SystemAccess{Writes: []string{ResourcePlayers, ResourceWorld, ResourcePhysics}}
+}
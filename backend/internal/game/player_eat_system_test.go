@@ -0,0 +1,72 @@
+package game
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"x-cells/backend/internal/world"
+)
+
+func TestPlayerEatSystem_LargerPlayerEatsSmaller(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	manager := world.NewManager()
+	gameTicker := NewGameTicker(20, nil, logger)
+	eatSystem := NewPlayerEatSystem(gameTicker, manager, logger)
+
+	var eaten []PlayerEaten
+	var died []PlayerDied
+	Subscribe(gameTicker.Events(), func(e PlayerEaten) { eaten = append(eaten, e) })
+	Subscribe(gameTicker.Events(), func(e PlayerDied) { died = append(died, e) })
+
+	for _, id := range []string{"big", "small", "rival", "mid"} {
+		manager.AddWorldObject(world.NewSphere(id, world.Vector3{}, 1, 1, "#ffffff", world.PhysicsTypeAmmo))
+	}
+
+	// small перекрыт на 70% и легче на 50% - съеден; rival тяжелее big меньше чем на 25%;
+	// mid мог бы съесть small, но его первым ест более массивный big; tiny далеко
+	gameTicker.AddPlayerWithRadiusAndMass("big", Vector3{}, 10, 100)
+	gameTicker.AddPlayerWithRadiusAndMass("small", Vector3{X: 8}, 5, 50)
+	gameTicker.AddPlayerWithRadiusAndMass("rival", Vector3{X: -5}, 9.6, 90)
+	gameTicker.AddPlayerWithRadiusAndMass("mid", Vector3{X: 14}, 6, 70)
+	gameTicker.AddPlayerWithRadiusAndMass("tiny", Vector3{X: 100}, 1, 1)
+	gameTicker.Events().Dispatch()
+
+	if err := eatSystem.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления: %v", err)
+	}
+	gameTicker.Events().Dispatch()
+
+	if gameTicker.GetPlayer("small") != nil {
+		t.Fatal("Съеденный игрок должен быть удален из игры")
+	}
+	if _, exists := manager.GetObject("small"); exists {
+		t.Error("Объект съеденного игрока должен быть удален из мира")
+	}
+	if mass := gameTicker.GetPlayer("big").Mass; mass != 150 {
+		t.Errorf("Ожидали массу 150 после поедания, получили %.1f", mass)
+	}
+	for _, id := range []string{"rival", "mid", "tiny"} {
+		if gameTicker.GetPlayer(id) == nil {
+			t.Errorf("Игрок %s не должен быть съеден", id)
+		}
+	}
+
+	if len(eaten) != 1 || eaten[0] != (PlayerEaten{EaterID: "big", VictimID: "small", MassGain: 50}) {
+		t.Errorf("Ожидали одно событие поедания small игроком big, получили %+v", eaten)
+	}
+	if len(died) != 1 || died[0] != (PlayerDied{PlayerID: "small", KillerID: "big"}) {
+		t.Errorf("Ожидали гибель small от big, получили %+v", died)
+	}
+
+	// Касание без достаточного перекрытия ничего не дает
+	eatSystem.SetConfig(PlayerEatConfig{MinOverlap: 0.9, MinMassAdvantage: 0.25})
+	gameTicker.AddPlayerWithRadiusAndMass("edge", Vector3{X: 100, Z: 1.5}, 1, 0.5)
+	if err := eatSystem.Update(50 * time.Millisecond); err != nil {
+		t.Fatalf("Ошибка обновления: %v", err)
+	}
+	if gameTicker.GetPlayer("edge") == nil {
+		t.Error("Перекрытие 25% меньше порога 90%, игрок не должен быть съеден")
+	}
+}
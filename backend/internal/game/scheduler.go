@@ -0,0 +1,299 @@
+package game
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// Общие ресурсы, которые системы объявляют в SystemAccess
+const (
+	ResourcePlayers = "players" // Игроки GameTicker
+	ResourceWorld   = "world"   // Объекты и террейн world.Manager
+	ResourcePhysics = "physics" // Состояние Bullet
+	ResourceFood    = "food"    // Еда SimpleFoodSystem
+)
+
+// SystemAccess описывает, с какими ресурсами работает система и после каких систем
+// она должна выполняться. Системы без конфликтов выполняются параллельно.
+type SystemAccess struct {
+	Reads  []string // Ресурсы только для чтения
+	Writes []string // Изменяемые ресурсы
+	After  []string // Имена систем, которые должны выполниться раньше в том же тике
+}
+
+// AccessDeclarer реализуют системы, которые можно выполнять параллельно с другими.
+// Система без объявления считается конфликтующей со всеми и выполняется отдельно.
+type AccessDeclarer interface {
+	Access() SystemAccess
+}
+
+// schedulePlan граф зависимостей систем одного тика.
+// Ребро i -> j означает, что j начинается только после завершения i.
+type schedulePlan struct {
+	systems    []TickSystem
+	access     []*SystemAccess // nil - система не объявила доступ
+	successors [][]int
+	preds      [][]int
+	order      []int // Топологический порядок для последовательного выполнения
+}
+
+// conflicts сообщает, нужно ли упорядочить две системы: одна пишет то,
+// что другая читает или пишет, либо хотя бы одна не объявила доступ
+func conflicts(a, b *SystemAccess) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	for _, w := range a.Writes {
+		if containsString(b.Writes, w) || containsString(b.Reads, w) {
+			return true
+		}
+	}
+	for _, w := range b.Writes {
+		if containsString(a.Reads, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSchedulePlan строит граф по системам, отсортированным по приоритету.
+// Конфликтующие системы упорядочиваются по приоритету (при равенстве - по порядку
+// регистрации), поэтому их порядок детерминирован. Ошибка возвращается при
+// циклической зависимости или ссылке на незарегистрированную систему; в этом
+// случае возвращается последовательный план в порядке приоритета.
+func buildSchedulePlan(systems []TickSystem) (*schedulePlan, error) {
+	n := len(systems)
+	access := systemAccesses(systems)
+	plan := &schedulePlan{
+		systems:    systems,
+		access:     access,
+		successors: make([][]int, n),
+		preds:      make([][]int, n),
+	}
+
+	index := make(map[string]int, n)
+	for i, system := range systems {
+		index[system.GetName()] = i
+	}
+
+	edges := make(map[[2]int]bool)
+	addEdge := func(from, to int) {
+		if edges[[2]int{from, to}] {
+			return
+		}
+		edges[[2]int{from, to}] = true
+		plan.successors[from] = append(plan.successors[from], to)
+		plan.preds[to] = append(plan.preds[to], from)
+	}
+
+	for j := 0; j < n; j++ {
+		for i := 0; i < j; i++ {
+			if conflicts(access[i], access[j]) {
+				addEdge(i, j)
+			}
+		}
+	}
+
+	var planErr error
+	for j, a := range access {
+		if a == nil {
+			continue
+		}
+		for _, name := range a.After {
+			i, ok := index[name]
+			if !ok {
+				planErr = fmt.Errorf("система %s зависит от незарегистрированной системы %s", systems[j].GetName(), name)
+				continue
+			}
+			addEdge(i, j)
+		}
+	}
+
+	if order, ok := plan.topologicalOrder(); ok {
+		plan.order = order
+	} else {
+		planErr = fmt.Errorf("циклическая зависимость между системами")
+	}
+
+	if planErr != nil {
+		return sequentialPlan(systems), planErr
+	}
+	return plan, nil
+}
+
+// systemAccesses собирает объявленный доступ систем
+func systemAccesses(systems []TickSystem) []*SystemAccess {
+	access := make([]*SystemAccess, len(systems))
+	for i, system := range systems {
+		if declarer, ok := system.(AccessDeclarer); ok {
+			a := declarer.Access()
+			access[i] = &a
+		}
+	}
+	return access
+}
+
+// sequentialPlan выстраивает системы в цепочку по приоритету
+func sequentialPlan(systems []TickSystem) *schedulePlan {
+	n := len(systems)
+	plan := &schedulePlan{
+		systems:    systems,
+		access:     systemAccesses(systems),
+		successors: make([][]int, n),
+		preds:      make([][]int, n),
+		order:      make([]int, n),
+	}
+	for i := range systems {
+		plan.order[i] = i
+		if i > 0 {
+			plan.successors[i-1] = []int{i}
+			plan.preds[i] = []int{i - 1}
+		}
+	}
+	return plan
+}
+
+// topologicalOrder возвращает порядок Кана; среди готовых систем первой идет
+// система с меньшим индексом, то есть с более высоким приоритетом
+func (p *schedulePlan) topologicalOrder() ([]int, bool) {
+	n := len(p.systems)
+	indegree := make([]int, n)
+	for i := range p.systems {
+		indegree[i] = len(p.preds[i])
+	}
+
+	order := make([]int, 0, n)
+	placed := make([]bool, n)
+	for len(order) < n {
+		next := -1
+		for i := 0; i < n; i++ {
+			if !placed[i] && indegree[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, false
+		}
+		placed[next] = true
+		order = append(order, next)
+		for _, j := range p.successors[next] {
+			indegree[j]--
+		}
+	}
+	return order, true
+}
+
+// criticalPath возвращает самую длинную по фактическому времени цепочку зависимых систем
+func (p *schedulePlan) criticalPath(durations []time.Duration) (time.Duration, []string) {
+	n := len(p.systems)
+	if n == 0 {
+		return 0, nil
+	}
+
+	finish := make([]time.Duration, n)
+	via := make([]int, n)
+	last := -1
+	for _, i := range p.order {
+		via[i] = -1
+		for _, pred := range p.preds[i] {
+			if via[i] < 0 || finish[pred] > finish[via[i]] {
+				via[i] = pred
+			}
+		}
+		finish[i] = durations[i]
+		if via[i] >= 0 {
+			finish[i] += finish[via[i]]
+		}
+		if last < 0 || finish[i] > finish[last] {
+			last = i
+		}
+	}
+
+	var path []string
+	for i := last; i >= 0; i = via[i] {
+		path = append([]string{p.systems[i].GetName()}, path...)
+	}
+	return finish[last], path
+}
+
+// SetSystemWorkers задает число горутин, выполняющих независимые системы.
+// 1 - строго последовательное выполнение, 0 - по числу процессоров.
+func (gt *GameTicker) SetSystemWorkers(workers int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+	gt.systemWorkers = workers
+}
+
+// rebuildPlan перестраивает граф систем. Вызывается под systemsMutex.
+func (gt *GameTicker) rebuildPlan() {
+	systems := make([]TickSystem, len(gt.systems))
+	copy(systems, gt.systems)
+
+	plan, err := buildSchedulePlan(systems)
+	if err != nil {
+		gt.logger.Printf("[GameTicker] ОШИБКА графа систем: %v, системы выполняются последовательно", err)
+	}
+	gt.plan = plan
+
+	names := make([]string, len(plan.order))
+	for i, index := range plan.order {
+		names[i] = plan.systems[index].GetName()
+	}
+	gt.events.setOrder(names)
+}
+
+// runPlanParallel выполняет системы на пуле горутин: система запускается,
+// как только завершились все ее предшественники в графе
+func (gt *GameTicker) runPlanParallel(plan *schedulePlan, workers int, run func(i int) time.Duration) []time.Duration {
+	n := len(plan.systems)
+	durations := make([]time.Duration, n)
+	remaining := make([]int, n)
+	ready := make(chan int, n)
+	done := make(chan int, n)
+
+	for i := range plan.systems {
+		remaining[i] = len(plan.preds[i])
+	}
+	for _, i := range plan.order {
+		if remaining[i] == 0 {
+			ready <- i
+		}
+	}
+
+	for w := 0; w < min(workers, n); w++ {
+		go func() {
+			for i := range ready {
+				durations[i] = run(i)
+				done <- i
+			}
+		}()
+	}
+
+	for completed := 0; completed < n; completed++ {
+		i := <-done
+		for _, j := range plan.successors[i] {
+			remaining[j]--
+			if remaining[j] == 0 {
+				ready <- j
+			}
+		}
+	}
+	close(ready)
+
+	return durations
+}
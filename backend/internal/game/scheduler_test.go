@@ -0,0 +1,153 @@
+package game
+
+import (
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// declaredSystem тестовая система с объявленным доступом к ресурсам
+type declaredSystem struct {
+	name     string
+	priority int
+	access   SystemAccess
+	update   func()
+}
+
+func (s *declaredSystem) Update(deltaTime time.Duration) error {
+	if s.update != nil {
+		s.update()
+	}
+	return nil
+}
+
+func (s *declaredSystem) GetName() string      { return s.name }
+func (s *declaredSystem) GetPriority() int     { return s.priority }
+func (s *declaredSystem) Access() SystemAccess { return s.access }
+
+func TestBuildSchedulePlan(t *testing.T) {
+	physics := &declaredSystem{name: "physics", access: SystemAccess{Writes: []string{ResourcePhysics}}}
+	food := &declaredSystem{name: "food", access: SystemAccess{Writes: []string{ResourceFood}}}
+	posSync := &declaredSystem{name: "sync", access: SystemAccess{Reads: []string{ResourcePhysics}, Writes: []string{ResourcePlayers}}}
+	save := &declaredSystem{name: "save", access: SystemAccess{Reads: []string{ResourceFood, ResourcePlayers}}}
+	legacy := &countingSystem{} // Без объявления доступа
+	metrics := &declaredSystem{name: "metrics", access: SystemAccess{After: []string{"physics"}}}
+
+	plan, err := buildSchedulePlan([]TickSystem{physics, food, posSync, save, legacy, metrics})
+	if err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+
+	expectedPreds := [][]int{
+		{},           // physics
+		{},           // food: другой ресурс
+		{0},          // sync читает physics
+		{1, 2},       // save читает food и players
+		{0, 1, 2, 3}, // legacy без объявления конфликтует со всеми
+		{4, 0},       // metrics: после legacy и явно после physics
+	}
+	for i, want := range expectedPreds {
+		got := plan.preds[i]
+		if len(got) == 0 && len(want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: ожидали предшественников %v, получили %v", plan.systems[i].GetName(), want, got)
+		}
+	}
+
+	if !reflect.DeepEqual(plan.order, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("Неверный топологический порядок: %v", plan.order)
+	}
+}
+
+func TestBuildSchedulePlan_InvalidDependencies(t *testing.T) {
+	a := &declaredSystem{name: "a", access: SystemAccess{After: []string{"b"}}}
+	b := &declaredSystem{name: "b", access: SystemAccess{After: []string{"a"}}}
+	c := &declaredSystem{name: "c", access: SystemAccess{After: []string{"missing"}}}
+
+	for _, systems := range [][]TickSystem{{a, b}, {c, a}} {
+		plan, err := buildSchedulePlan(systems)
+		if err == nil {
+			t.Fatal("Ожидали ошибку графа")
+		}
+		// Откат на последовательное выполнение по приоритету
+		if !reflect.DeepEqual(plan.order, []int{0, 1}) || !reflect.DeepEqual(plan.preds[1], []int{0}) {
+			t.Errorf("Ожидали последовательный план, получили %v / %v", plan.order, plan.preds)
+		}
+	}
+}
+
+func TestSchedulePlan_CriticalPath(t *testing.T) {
+	a := &declaredSystem{name: "a", access: SystemAccess{Writes: []string{"x"}}}
+	b := &declaredSystem{name: "b", access: SystemAccess{Writes: []string{"y"}}}
+	c := &declaredSystem{name: "c", access: SystemAccess{Reads: []string{"x", "y"}}}
+
+	plan, err := buildSchedulePlan([]TickSystem{a, b, c})
+	if err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+
+	duration, path := plan.criticalPath([]time.Duration{3 * time.Millisecond, 5 * time.Millisecond, 2 * time.Millisecond})
+	if duration != 7*time.Millisecond {
+		t.Errorf("Ожидали критический путь 7ms, получили %v", duration)
+	}
+	if !reflect.DeepEqual(path, []string{"b", "c"}) {
+		t.Errorf("Ожидали путь [b c], получили %v", path)
+	}
+}
+
+func TestGameTicker_ParallelSystems(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	gameTicker.SetSystemWorkers(4)
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, name)
+	}
+
+	// Независимые системы ждут друг друга: при последовательном выполнении тик зависнет
+	barrier := sync.WaitGroup{}
+	barrier.Add(2)
+	meet := func(name string) func() {
+		return func() {
+			barrier.Done()
+			barrier.Wait()
+			record(name)
+		}
+	}
+
+	gameTicker.RegisterSystem(&declaredSystem{name: "left", priority: 1,
+		access: SystemAccess{Writes: []string{"left"}}, update: meet("left")})
+	gameTicker.RegisterSystem(&declaredSystem{name: "right", priority: 2,
+		access: SystemAccess{Writes: []string{"right"}}, update: meet("right")})
+	gameTicker.RegisterSystem(&declaredSystem{name: "merge", priority: 3,
+		access: SystemAccess{Reads: []string{"left", "right"}}, update: func() { record("merge") }})
+
+	done := make(chan struct{})
+	go func() {
+		gameTicker.Tick()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Независимые системы не выполнялись параллельно")
+	}
+
+	if len(order) != 3 || order[2] != "merge" {
+		t.Errorf("Зависимая система должна выполняться последней: %v", order)
+	}
+
+	stats := gameTicker.GetStats()["critical_path"].(map[string]interface{})
+	if systems := stats["systems"].([]string); len(systems) != 2 || systems[1] != "merge" {
+		t.Errorf("Критический путь должен заканчиваться merge: %v", systems)
+	}
+}
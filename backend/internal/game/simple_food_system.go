@@ -1,7 +1,6 @@
 package game
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"math"
@@ -12,14 +11,16 @@ import (
 
 // SimpleFoodSystem - упрощенная система еды для Фазы 1
 type SimpleFoodSystem struct {
+	SystemClock
+	SystemContext
+
 	name       string
 	priority   int
 	gameTicker *GameTicker
 	logger     *log.Logger
 
-	// Еда в мире
-	foodItems  map[string]*SimpleFood
-	foodMutex  sync.RWMutex
+	// Еда хранится сущностями в gameTicker.Entities() с компонентом FoodState
+	foodMutex  sync.Mutex // Защищает спавн и nextFoodID
 	nextFoodID uint64
 
 	// Настройки спавна
@@ -29,22 +30,14 @@ type SimpleFoodSystem struct {
 
 	// Зона спавна (статичная еда на земле)
 	spawnRadius float64 // Радиус зоны спавна
-	groundLevel float64 // Уровень земли
+	groundLevel float64 // Уровень земли (если террейн недоступен)
 
 	// Радиус коллизий
 	foodRadius float64 // Радиус еды
 	// playerRadius убран - будем брать реальный радиус каждого игрока
 
-	// Интерфейс для отправки событий (будет установлен извне)
-	broadcaster FoodEventBroadcaster
-}
-
-// FoodEventBroadcaster интерфейс для отправки событий еды
-type FoodEventBroadcaster interface {
-	BroadcastFoodConsumed(playerID, foodID string, massGain float64)
-	BroadcastFoodSpawned(food interface{})
-	BroadcastFoodState(foodItems interface{})
-	BroadcastPlayerSizeUpdate(playerID string, newRadius float64, newMass float64)
+	// Проверять коллизии с едой в том положении, в котором ее видел клиент игрока
+	lagCompensation bool
 }
 
 // SimpleFood - простой объект еды
@@ -66,7 +59,6 @@ func NewSimpleFoodSystem(gameTicker *GameTicker, logger *log.Logger) *SimpleFood
 		priority:   25, // После физики
 		gameTicker: gameTicker,
 		logger:     logger,
-		foodItems:  make(map[string]*SimpleFood),
 		nextFoodID: 1,
 
 		// Настройки (консервативные для начала)
@@ -90,17 +82,12 @@ func (sfs *SimpleFoodSystem) Update(deltaTime time.Duration) error {
 	// Проверяем коллизии с игроками
 	sfs.checkCollisions()
 
-	// Логирование каждые 20 тиков (1 секунда)
-	if sfs.gameTicker.GetTickCount()%20 == 0 {
-		sfs.logStats()
-	}
-
 	return nil
 }
 
 // spawnFood создает новую еду
 func (sfs *SimpleFoodSystem) spawnFood() {
-	now := time.Now()
+	now := sfs.Now()
 
 	// Проверяем интервал спавна
 	if now.Sub(sfs.lastSpawn) < sfs.spawnInterval {
@@ -111,7 +98,7 @@ func (sfs *SimpleFoodSystem) spawnFood() {
 	defer sfs.foodMutex.Unlock()
 
 	// Проверяем лимит
-	if len(sfs.foodItems) >= sfs.maxFood {
+	if sfs.foodCount() >= sfs.maxFood {
 		return
 	}
 
@@ -119,15 +106,13 @@ func (sfs *SimpleFoodSystem) spawnFood() {
 
 	// Создаем еду
 	food := sfs.createRandomFood()
-	sfs.foodItems[food.ID] = food
+	sfs.addFood(food)
 
 	sfs.logger.Printf("[SimpleFoodSystem] Создана еда %s в (%.1f, %.1f, %.1f)",
 		food.ID, food.X, food.Y, food.Z)
 
-	// Уведомляем клиентов о новой еде
-	if sfs.broadcaster != nil {
-		sfs.broadcaster.BroadcastFoodSpawned(food)
-	}
+	// Уведомляем подписчиков о новой еде
+	sfs.gameTicker.Events().Publish(sfs.name, FoodSpawned{Food: *food})
 }
 
 // createRandomFood создает случайную еду на земле
@@ -138,7 +123,12 @@ func (sfs *SimpleFoodSystem) createRandomFood() *SimpleFood {
 
 	x := math.Cos(angle) * distance
 	z := math.Sin(angle) * distance
+
+	// Кладем еду на поверхность террейна, если он есть
 	y := sfs.groundLevel
+	if groundHeight, ok := sfs.gameTicker.GroundHeightAt(x, z); ok {
+		y = groundHeight + sfs.foodRadius
+	}
 
 	food := &SimpleFood{
 		ID:        fmt.Sprintf("food_%d", sfs.nextFoodID),
@@ -148,131 +138,178 @@ func (sfs *SimpleFoodSystem) createRandomFood() *SimpleFood {
 		Radius:    sfs.foodRadius,
 		Mass:      10.0,      // Все еда дает +1 массу
 		Color:     "#90EE90", // Светло-зеленый
-		SpawnTime: time.Now(),
+		SpawnTime: sfs.Now(),
 	}
 
 	sfs.nextFoodID++
 	return food
 }
 
+// foodCandidate еда и игрок в момент проверки коллизий
+type foodCandidate struct {
+	id     string
+	pos    Position
+	radius float64
+	rtt    time.Duration
+}
+
+// SetLagCompensation включает компенсацию задержки: игрок может съесть еду там,
+// где ее показывал его клиент, и не может съесть еду, которую клиент еще не видел
+func (sfs *SimpleFoodSystem) SetLagCompensation(enabled bool) {
+	sfs.lagCompensation = enabled
+}
+
 // checkCollisions проверяет коллизии игроков с едой
 func (sfs *SimpleFoodSystem) checkCollisions() {
-	// Получаем всех игроков напрямую из gameTicker
-	players := sfs.gameTicker.GetAllPlayers()
+	entities := sfs.gameTicker.Entities()
+
+	// Берем позиции и радиусы прямо из компонентов, без копирования игроков
+	var players []foodCandidate
+	Query3(entities, func(_ Entity, state *PlayerState, pos *Position, radius *Radius) {
+		players = append(players, foodCandidate{id: state.ID, pos: *pos, radius: float64(*radius), rtt: state.RTT})
+	})
 
-	// === ОТЛАДКА: Логируем информацию о игроках ===
 	if len(players) == 0 {
-		// Логируем только каждые несколько секунд
-		if sfs.gameTicker.GetTickCount()%200 == 0 { // Каждые 10 секунд при 20 TPS
-			sfs.logger.Printf("[SimpleFoodSystem] Игроков: %d, Еды: %d/%d", len(players), len(sfs.foodItems), sfs.maxFood)
-		}
 		return
 	}
 
-	sfs.foodMutex.Lock()
-	defer sfs.foodMutex.Unlock()
-
-	// Логируем текущую ситуацию каждые 20 тиков
-	if sfs.gameTicker.GetTickCount()%20 == 0 {
-		sfs.logger.Printf("[SimpleFoodSystem] Игроков: %d, Еды: %d/%d", len(players), len(sfs.foodItems), sfs.maxFood)
-		for playerID, player := range players {
-			sfs.logger.Printf("[SimpleFoodSystem] Игрок %s в позиции (%.2f, %.2f, %.2f)",
-				playerID, player.Position.X, player.Position.Y, player.Position.Z)
+	// Поедание меняет массу игрока через GameTicker, поэтому сначала собираем еду,
+	// а обрабатываем коллизии уже вне обхода хранилища
+	var foods []*SimpleFood
+	Query4(entities, func(_ Entity, state *FoodState, pos *Position, radius *Radius, mass *Mass) {
+		foods = append(foods, newFoodSnapshot(state, pos, radius, mass))
+	})
+
+	// Состояние мира, которое видел клиент каждого игрока
+	seen := make(map[string]*TickSnapshot)
+	if sfs.lagCompensation {
+		for _, player := range players {
+			if player.rtt == 0 {
+				continue // Задержка еще не измерена
+			}
+			if snapshot, ok := sfs.gameTicker.RewindLatency(player.rtt); ok {
+				seen[player.id] = snapshot
+			}
 		}
 	}
 
-	toRemove := make([]string, 0)
+	for _, food := range foods {
+		for _, player := range players {
+			foodX, foodZ := food.X, food.Z
+			if snapshot, ok := seen[player.id]; ok {
+				seenFood, visible := snapshot.Food[food.ID]
+				if !visible {
+					continue // Клиент игрока еще не видел эту еду
+				}
+				foodX, foodZ = seenFood.Position.X, seenFood.Position.Z
+			}
 
-	for _, food := range sfs.foodItems {
-		for playerID, player := range players {
 			// Простая проверка расстояния в 2D (игнорируем Y)
-			dx := player.Position.X - food.X
-			dz := player.Position.Z - food.Z
+			dx := player.pos.X - foodX
+			dz := player.pos.Z - foodZ
 			distance := math.Sqrt(dx*dx + dz*dz)
 
 			// Получаем реальный радиус игрока (пропускаем игроков с невалидным радиусом)
-			playerRadius := player.Radius
+			playerRadius := player.radius
 			if playerRadius <= 0 {
-				// Логируем предупреждение только периодически
-				if sfs.gameTicker.GetTickCount()%200 == 0 {
-					sfs.logger.Printf("[SimpleFoodSystem] ПРЕДУПРЕЖДЕНИЕ: Игрок %s имеет невалидный радиус %.2f, пропускаем",
-						playerID, playerRadius)
-				}
-				continue // Пропускаем этого игрока
+				continue
 			}
 
 			collisionDistance := playerRadius + food.Radius
 
-			// Логируем коллизии только периодически для отладки
-			if distance <= collisionDistance*1.5 && sfs.gameTicker.GetTickCount()%100 == 0 { // Каждые 5 секунд
-				sfs.logger.Printf("[SimpleFoodSystem] ОТЛАДКА: Игрок %s (радиус=%.1f) рядом с едой %s: расстояние=%.2f, нужно<=%.2f",
-					playerID, playerRadius, food.ID, distance, collisionDistance)
-			}
-
 			if distance <= collisionDistance {
 				// КОЛЛИЗИЯ! Игрок съел еду
 				sfs.logger.Printf("[SimpleFoodSystem] Игрок %s (радиус=%.1f) съел еду %s (расстояние: %.2f)",
-					playerID, playerRadius, food.ID, distance)
-
-				// Увеличиваем массу игрока через GameTicker
-				sfs.gameTicker.UpdatePlayerMass(playerID, food.Mass)
+					player.id, playerRadius, food.ID, distance)
 
-				// Помечаем еду для удаления
-				toRemove = append(toRemove, food.ID)
+				// Удаляем еду из мира
+				entities.DestroyKey(food.ID)
 
-				// Уведомляем клиентов
-				sfs.notifyFoodConsumed(playerID, food)
+				// Увеличиваем массу игрока через GameTicker
+				sfs.gameTicker.UpdatePlayerMass(sfs.Context(), player.id, food.Mass)
+
+				// Уведомляем подписчиков
+				sfs.gameTicker.Events().Publish(sfs.name, FoodConsumed{
+					PlayerID: player.id,
+					FoodID:   food.ID,
+					MassGain: food.Mass,
+				})
 				break
 			}
 		}
 	}
+}
 
-	// Удаляем съеденную еду
-	for _, foodID := range toRemove {
-		delete(sfs.foodItems, foodID)
-	}
+// GetFoodItems возвращает копию всех объектов еды для синхронизации
+func (sfs *SimpleFoodSystem) GetFoodItems() map[string]*SimpleFood {
+	result := make(map[string]*SimpleFood)
+	Query4(sfs.gameTicker.Entities(), func(_ Entity, state *FoodState, pos *Position, radius *Radius, mass *Mass) {
+		result[state.ID] = newFoodSnapshot(state, pos, radius, mass)
+	})
+	return result
 }
 
-// notifyFoodConsumed уведомляет клиентов о съеденной еде
-func (sfs *SimpleFoodSystem) notifyFoodConsumed(playerID string, food *SimpleFood) {
-	// Отправляем событие через broadcaster
-	if sfs.broadcaster != nil {
-		sfs.broadcaster.BroadcastFoodConsumed(playerID, food.ID, food.Mass)
-	} else {
-		// Fallback - логируем событие
-		event := map[string]interface{}{
-			"type":      "food_consumed",
-			"player_id": playerID,
-			"food_id":   food.ID,
-			"mass_gain": food.Mass,
-		}
+// RestoreFood заменяет еду в мире на восстановленную из сохранения
+func (sfs *SimpleFoodSystem) RestoreFood(items []*SimpleFood) {
+	sfs.foodMutex.Lock()
+	defer sfs.foodMutex.Unlock()
 
-		eventData, _ := json.Marshal(event)
-		sfs.logger.Printf("[SimpleFoodSystem] Событие: %s", string(eventData))
+	entities := sfs.gameTicker.Entities()
+	var stale []Entity
+	Each(entities, func(id Entity, _ *FoodState) {
+		stale = append(stale, id)
+	})
+	for _, id := range stale {
+		entities.Destroy(id)
 	}
-}
-
-// GetFoodItems возвращает копию всех объектов еды для синхронизации
-func (sfs *SimpleFoodSystem) GetFoodItems() map[string]*SimpleFood {
-	sfs.foodMutex.RLock()
-	defer sfs.foodMutex.RUnlock()
 
-	result := make(map[string]*SimpleFood)
-	for id, food := range sfs.foodItems {
-		// Создаем копию
+	for _, food := range items {
 		foodCopy := *food
-		result[id] = &foodCopy
+		foodCopy.SpawnTime = sfs.Now()
+		sfs.addFood(&foodCopy)
+
+		// Новые ID не должны пересекаться с восстановленными
+		var id uint64
+		if _, err := fmt.Sscanf(food.ID, "food_%d", &id); err == nil && id >= sfs.nextFoodID {
+			sfs.nextFoodID = id + 1
+		}
 	}
-	return result
+
+	sfs.logger.Printf("[SimpleFoodSystem] Восстановлено еды из сохранения: %d", len(items))
 }
 
-// logStats выводит статистику системы
-func (sfs *SimpleFoodSystem) logStats() {
-	sfs.foodMutex.RLock()
-	count := len(sfs.foodItems)
-	sfs.foodMutex.RUnlock()
+// addFood создает сущность еды
+func (sfs *SimpleFoodSystem) addFood(food *SimpleFood) {
+	entities := sfs.gameTicker.Entities()
+	id := entities.Create(food.ID)
+	Set(entities, id, FoodState{ID: food.ID, Color: food.Color, SpawnTime: food.SpawnTime})
+	Set(entities, id, Position{X: food.X, Y: food.Y, Z: food.Z})
+	Set(entities, id, Radius(food.Radius))
+	Set(entities, id, Mass(food.Mass))
+}
 
-	sfs.logger.Printf("[SimpleFoodSystem] Еды в мире: %d/%d", count, sfs.maxFood)
+// foodCount возвращает количество еды в мире
+func (sfs *SimpleFoodSystem) foodCount() int {
+	return Count[FoodState](sfs.gameTicker.Entities())
+}
+
+func newFoodSnapshot(state *FoodState, pos *Position, radius *Radius, mass *Mass) *SimpleFood {
+	return &SimpleFood{
+		ID:        state.ID,
+		X:         pos.X,
+		Y:         pos.Y,
+		Z:         pos.Z,
+		Radius:    float64(*radius),
+		Mass:      float64(*mass),
+		Color:     state.Color,
+		SpawnTime: state.SpawnTime,
+	}
+}
+
+// LogStats выводит статистику системы (по расписанию StatsLogSystem)
+func (sfs *SimpleFoodSystem) LogStats() {
+	sfs.logger.Printf("[SimpleFoodSystem] Игроков: %d, еды в мире: %d/%d",
+		Count[PlayerState](sfs.gameTicker.Entities()), sfs.foodCount(), sfs.maxFood)
 }
 
 // GetName возвращает имя системы
@@ -285,7 +322,10 @@ func (sfs *SimpleFoodSystem) GetPriority() int {
 	return sfs.priority
 }
 
-// SetBroadcaster устанавливает интерфейс для отправки событий
-func (sfs *SimpleFoodSystem) SetBroadcaster(broadcaster FoodEventBroadcaster) {
-	sfs.broadcaster = broadcaster
+// Access объявляет ресурсы системы для планировщика
+func (sfs *SimpleFoodSystem) Access() SystemAccess {
+	return SystemAccess{
+		Reads:  []string{ResourceWorld},
+		Writes: []string{ResourceFood, ResourcePlayers, ResourcePhysics},
+	}
 }
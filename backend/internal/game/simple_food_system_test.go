@@ -10,37 +10,21 @@ import (
 	"x-cells/backend/internal/world"
 )
 
-// MockBroadcaster для тестирования
-type MockBroadcaster struct {
-	ConsumedEvents []ConsumedEvent
-	SpawnedEvents  []interface{}
-	StateEvents    []interface{}
+// eventRecorder записывает события еды из шины GameTicker
+type eventRecorder struct {
+	ConsumedEvents []FoodConsumed
+	SpawnedEvents  []FoodSpawned
 }
 
-type ConsumedEvent struct {
-	PlayerID string
-	FoodID   string
-	MassGain float64
-}
-
-func (mb *MockBroadcaster) BroadcastFoodConsumed(playerID, foodID string, massGain float64) {
-	mb.ConsumedEvents = append(mb.ConsumedEvents, ConsumedEvent{
-		PlayerID: playerID,
-		FoodID:   foodID,
-		MassGain: massGain,
+func recordFoodEvents(gameTicker *GameTicker) *eventRecorder {
+	recorder := &eventRecorder{}
+	Subscribe(gameTicker.Events(), func(e FoodConsumed) {
+		recorder.ConsumedEvents = append(recorder.ConsumedEvents, e)
 	})
-}
-
-func (mb *MockBroadcaster) BroadcastFoodSpawned(food interface{}) {
-	mb.SpawnedEvents = append(mb.SpawnedEvents, food)
-}
-
-func (mb *MockBroadcaster) BroadcastFoodState(foodItems interface{}) {
-	mb.StateEvents = append(mb.StateEvents, foodItems)
-}
-
-func (mb *MockBroadcaster) BroadcastPlayerSizeUpdate(playerID string, newRadius float64, newMass float64) {
-	// Mock implementation - can be extended if needed for testing
+	Subscribe(gameTicker.Events(), func(e FoodSpawned) {
+		recorder.SpawnedEvents = append(recorder.SpawnedEvents, e)
+	})
+	return recorder
 }
 
 // Создаем тестовый GameTicker с игроками
@@ -60,9 +44,8 @@ func TestSimpleFoodSystem_Collisions(t *testing.T) {
 	// Создаем систему еды
 	foodSystem := NewSimpleFoodSystem(gameTicker, logger)
 
-	// Создаем mock broadcaster
-	mockBroadcaster := &MockBroadcaster{}
-	foodSystem.SetBroadcaster(mockBroadcaster)
+	// Подписываемся на события еды
+	recorder := recordFoodEvents(gameTicker)
 
 	// === Тест 1: Добавляем игрока далеко от еды ===
 	playerID1 := "test_player_1"
@@ -79,17 +62,18 @@ func TestSimpleFoodSystem_Collisions(t *testing.T) {
 		Mass:   1.0,
 		Color:  "#90EE90",
 	}
-	foodSystem.foodItems[food1.ID] = food1
+	foodSystem.addFood(food1)
 
 	// Проверяем коллизии - НЕ должно быть столкновения
 	foodSystem.checkCollisions()
+	gameTicker.Events().Dispatch()
 
-	if len(mockBroadcaster.ConsumedEvents) != 0 {
-		t.Errorf("Ожидали 0 событий поглощения, получили %d", len(mockBroadcaster.ConsumedEvents))
+	if len(recorder.ConsumedEvents) != 0 {
+		t.Errorf("Ожидали 0 событий поглощения, получили %d", len(recorder.ConsumedEvents))
 	}
 
-	if len(foodSystem.foodItems) != 1 {
-		t.Errorf("Еда не должна исчезнуть, осталось %d", len(foodSystem.foodItems))
+	if foodSystem.foodCount() != 1 {
+		t.Errorf("Еда не должна исчезнуть, осталось %d", foodSystem.foodCount())
 	}
 
 	// === Тест 2: Добавляем игрока ТОЧНО на еду ===
@@ -99,18 +83,19 @@ func TestSimpleFoodSystem_Collisions(t *testing.T) {
 
 	// Проверяем коллизии - ДОЛЖНО быть столкновение
 	foodSystem.checkCollisions()
+	gameTicker.Events().Dispatch()
 
-	if len(mockBroadcaster.ConsumedEvents) != 1 {
-		t.Errorf("Ожидали 1 событие поглощения, получили %d", len(mockBroadcaster.ConsumedEvents))
+	if len(recorder.ConsumedEvents) != 1 {
+		t.Errorf("Ожидали 1 событие поглощения, получили %d", len(recorder.ConsumedEvents))
 	}
 
-	if len(foodSystem.foodItems) != 0 {
-		t.Errorf("Еда должна исчезнуть, осталось %d", len(foodSystem.foodItems))
+	if foodSystem.foodCount() != 0 {
+		t.Errorf("Еда должна исчезнуть, осталось %d", foodSystem.foodCount())
 	}
 
 	// Проверяем детали события
-	if len(mockBroadcaster.ConsumedEvents) > 0 {
-		event := mockBroadcaster.ConsumedEvents[0]
+	if len(recorder.ConsumedEvents) > 0 {
+		event := recorder.ConsumedEvents[0]
 		if event.PlayerID != playerID2 {
 			t.Errorf("Неверный playerID: ожидали %s, получили %s", playerID2, event.PlayerID)
 		}
@@ -129,8 +114,7 @@ func TestSimpleFoodSystem_CollisionBoundary(t *testing.T) {
 	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
 
 	foodSystem := NewSimpleFoodSystem(gameTicker, logger)
-	mockBroadcaster := &MockBroadcaster{}
-	foodSystem.SetBroadcaster(mockBroadcaster)
+	recorder := recordFoodEvents(gameTicker)
 
 	// Создаем еду в центре
 	food := &SimpleFood{
@@ -142,7 +126,7 @@ func TestSimpleFoodSystem_CollisionBoundary(t *testing.T) {
 		Mass:   1.0,
 		Color:  "#90EE90",
 	}
-	foodSystem.foodItems[food.ID] = food
+	foodSystem.addFood(food)
 
 	// Тест: игрок на ТОЧНОЙ границе коллизии
 	// collisionDistance = playerRadius(1.0) + foodRadius(0.5) = 1.5
@@ -151,10 +135,11 @@ func TestSimpleFoodSystem_CollisionBoundary(t *testing.T) {
 	gameTicker.AddPlayer(playerID, boundaryPosition)
 
 	foodSystem.checkCollisions()
+	gameTicker.Events().Dispatch()
 
 	// На границе ДОЛЖНА быть коллизия (distance <= collisionDistance)
-	if len(mockBroadcaster.ConsumedEvents) != 1 {
-		t.Errorf("На границе должна быть коллизия, получили %d событий", len(mockBroadcaster.ConsumedEvents))
+	if len(recorder.ConsumedEvents) != 1 {
+		t.Errorf("На границе должна быть коллизия, получили %d событий", len(recorder.ConsumedEvents))
 	}
 
 	// Тест: игрок ЧУТЬ за границей
@@ -167,20 +152,22 @@ func TestSimpleFoodSystem_CollisionBoundary(t *testing.T) {
 		Mass:   1.0,
 		Color:  "#90EE90",
 	}
-	foodSystem.foodItems[food2.ID] = food2
+	foodSystem.addFood(food2)
 
 	// Очищаем события
-	mockBroadcaster.ConsumedEvents = []ConsumedEvent{}
+	recorder.ConsumedEvents = nil
 
-	// Обновляем позицию игрока чуть за границу
-	player := gameTicker.GetPlayer(playerID)
-	player.Position.X = 1.51 // Чуть больше чем 1.5
+	// Игрок вырос после первой еды, граница сместилась на его новый радиус.
+	// GetPlayer возвращает копию, поэтому позицию меняем в компоненте.
+	boundary := gameTicker.GetPlayerRadius(playerID) + food2.Radius
+	gameTicker.UpdatePlayerPosition(playerID, Vector3{X: boundary + 0.01, Y: 1.0, Z: 0.0}) // Чуть за границей
 
 	foodSystem.checkCollisions()
+	gameTicker.Events().Dispatch()
 
 	// За границей НЕ должно быть коллизии
-	if len(mockBroadcaster.ConsumedEvents) != 0 {
-		t.Errorf("За границей не должно быть коллизии, получили %d событий", len(mockBroadcaster.ConsumedEvents))
+	if len(recorder.ConsumedEvents) != 0 {
+		t.Errorf("За границей не должно быть коллизии, получили %d событий", len(recorder.ConsumedEvents))
 	}
 }
 
@@ -190,8 +177,7 @@ func TestSimpleFoodSystem_MultiplePlayersOneFood(t *testing.T) {
 	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
 
 	foodSystem := NewSimpleFoodSystem(gameTicker, logger)
-	mockBroadcaster := &MockBroadcaster{}
-	foodSystem.SetBroadcaster(mockBroadcaster)
+	recorder := recordFoodEvents(gameTicker)
 
 	// Создаем еду
 	food := &SimpleFood{
@@ -203,7 +189,7 @@ func TestSimpleFoodSystem_MultiplePlayersOneFood(t *testing.T) {
 		Mass:   1.0,
 		Color:  "#90EE90",
 	}
-	foodSystem.foodItems[food.ID] = food
+	foodSystem.addFood(food)
 
 	// Добавляем двух игроков на одинаковом расстоянии от еды
 	player1ID := "player1"
@@ -216,15 +202,16 @@ func TestSimpleFoodSystem_MultiplePlayersOneFood(t *testing.T) {
 	gameTicker.AddPlayer(player2ID, pos2)
 
 	foodSystem.checkCollisions()
+	gameTicker.Events().Dispatch()
 
 	// Должно быть только 1 событие (один игрок съел еду)
-	if len(mockBroadcaster.ConsumedEvents) != 1 {
-		t.Errorf("Ожидали 1 событие, получили %d", len(mockBroadcaster.ConsumedEvents))
+	if len(recorder.ConsumedEvents) != 1 {
+		t.Errorf("Ожидали 1 событие, получили %d", len(recorder.ConsumedEvents))
 	}
 
 	// Еда должна исчезнуть
-	if len(foodSystem.foodItems) != 0 {
-		t.Errorf("Еда должна исчезнуть, осталось %d", len(foodSystem.foodItems))
+	if foodSystem.foodCount() != 0 {
+		t.Errorf("Еда должна исчезнуть, осталось %d", foodSystem.foodCount())
 	}
 }
 
@@ -234,8 +221,6 @@ func TestSimpleFoodSystem_MassUpdate(t *testing.T) {
 	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
 
 	foodSystem := NewSimpleFoodSystem(gameTicker, logger)
-	mockBroadcaster := &MockBroadcaster{}
-	foodSystem.SetBroadcaster(mockBroadcaster)
 
 	// Добавляем игрока
 	playerID := "mass_player"
@@ -256,12 +241,13 @@ func TestSimpleFoodSystem_MassUpdate(t *testing.T) {
 		Mass:   2.5, // Особая масса
 		Color:  "#90EE90",
 	}
-	foodSystem.foodItems[food.ID] = food
+	foodSystem.addFood(food)
 
 	foodSystem.checkCollisions()
+	gameTicker.Events().Dispatch()
 
 	// Проверяем что масса увеличилась
-	finalMass := player.Mass
+	finalMass := gameTicker.GetPlayer(playerID).Mass
 	expectedMass := initialMass + 2.5
 
 	if finalMass != expectedMass {
@@ -295,7 +281,7 @@ func BenchmarkSimpleFoodSystem_Collisions(b *testing.B) {
 			Mass:   1.0,
 			Color:  "#90EE90",
 		}
-		foodSystem.foodItems[food.ID] = food
+		foodSystem.addFood(food)
 	}
 
 	b.ResetTimer()
@@ -0,0 +1,56 @@
+package game
+
+import "time"
+
+// StatsReporter реализуют системы, которые умеют выводить сводку своего состояния.
+// Сами системы не решают, когда ее выводить: частотой владеет StatsLogSystem
+type StatsReporter interface {
+	LogStats()
+}
+
+// StatsLogSystem периодически выводит сводки систем. Частота меняется, как у
+// любой системы, через SetSystemRate
+type StatsLogSystem struct {
+	name      string
+	priority  int
+	reporters []StatsReporter
+}
+
+// NewStatsLogSystem создает систему сводок для переданных систем
+func NewStatsLogSystem(reporters ...StatsReporter) *StatsLogSystem {
+	return &StatsLogSystem{
+		name:      "StatsLogSystem",
+		priority:  190, // В конце тика, перед метриками
+		reporters: reporters,
+	}
+}
+
+// Update выводит сводки всех систем
+func (sls *StatsLogSystem) Update(deltaTime time.Duration) error {
+	for _, reporter := range sls.reporters {
+		reporter.LogStats()
+	}
+	return nil
+}
+
+// GetName возвращает имя системы
+func (sls *StatsLogSystem) GetName() string {
+	return sls.name
+}
+
+// GetPriority возвращает приоритет системы
+func (sls *StatsLogSystem) GetPriority() int {
+	return sls.priority
+}
+
+// DefaultRate выводит сводки раз в 10 секунд
+func (sls *StatsLogSystem) DefaultRate() SystemRate {
+	return SystemRate{Hz: 1.0 / 10}
+}
+
+// Access объявляет ресурсы системы для планировщика: сводки только читают состояние
+func (sls *StatsLogSystem) Access() SystemAccess {
+	return SystemAccess{
+		Reads: []string{ResourceWorld, ResourcePlayers, ResourcePhysics, ResourceFood},
+	}
+}
@@ -0,0 +1,33 @@
+package game
+
+import (
+	"io"
+	"log"
+	"testing"
+)
+
+// countingReporter считает вызовы LogStats
+type countingReporter struct {
+	calls int
+}
+
+func (r *countingReporter) LogStats() {
+	r.calls++
+}
+
+func TestStatsLogSystem_ReportsAtDefaultRate(t *testing.T) {
+	gameTicker := NewGameTicker(20, nil, log.New(io.Discard, "", 0))
+	gameTicker.EnableFixedTimestep(DefaultMaxCatchUpTicks)
+
+	first, second := &countingReporter{}, &countingReporter{}
+	gameTicker.RegisterSystem(NewStatsLogSystem(first, second))
+
+	// 20 секунд игрового времени при 20 TPS: сводки раз в 10 секунд
+	gameTicker.Pause()
+	if _, err := gameTicker.Step(400); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	if first.calls != 2 || second.calls != 2 {
+		t.Errorf("Ожидали по 2 сводки от каждой системы, получили %d и %d", first.calls, second.calls)
+	}
+}
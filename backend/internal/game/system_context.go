@@ -0,0 +1,29 @@
+package game
+
+import "context"
+
+// ContextAware реализуют системы, которые вызывают физику. Перед каждым Update
+// GameTicker передает им контекст, помеченный номером тика (transport.WithTick):
+// по нему вызовы попадают в трассу тика.
+type ContextAware interface {
+	SetContext(ctx context.Context)
+}
+
+// SystemContext встраивается в системы и реализует ContextAware.
+// Вне игрового цикла (например, в тестах) используется context.Background().
+type SystemContext struct {
+	ctx context.Context
+}
+
+// SetContext устанавливает контекст текущего выполнения системы
+func (c *SystemContext) SetContext(ctx context.Context) {
+	c.ctx = ctx
+}
+
+// Context возвращает контекст для вызовов физики из Update
+func (c *SystemContext) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
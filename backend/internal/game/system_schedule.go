@@ -0,0 +1,237 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrSystemNotFound система с таким именем не зарегистрирована
+var ErrSystemNotFound = errors.New("система не найдена")
+
+// SystemRate частота выполнения системы. Нулевое значение - каждый тик.
+type SystemRate struct {
+	EveryTicks int     // Выполнять раз в N тиков
+	Hz         float64 // Выполнять с частотой Hz по игровому времени (не чаще частоты тиков)
+}
+
+// Validate проверяет, что задан не более чем один способ ограничения частоты
+func (r SystemRate) Validate() error {
+	switch {
+	case r.EveryTicks < 0 || r.Hz < 0:
+		return fmt.Errorf("частота не может быть отрицательной")
+	case r.EveryTicks > 0 && r.Hz > 0:
+		return fmt.Errorf("нужно задать либо число тиков, либо частоту в Hz")
+	}
+	return nil
+}
+
+func (r SystemRate) String() string {
+	switch {
+	case r.Hz > 0:
+		return fmt.Sprintf("%.2f Hz", r.Hz)
+	case r.EveryTicks > 1:
+		return fmt.Sprintf("каждые %d тиков", r.EveryTicks)
+	default:
+		return "каждый тик"
+	}
+}
+
+// RateProvider реализуют системы, которым не нужно выполняться каждый тик.
+// Частота применяется при регистрации и может быть изменена через SetSystemRate.
+type RateProvider interface {
+	DefaultRate() SystemRate
+}
+
+// systemState расписание зарегистрированной системы
+type systemState struct {
+	rate        SystemRate
+	enabled     bool
+	initialized bool // Init выполнен, при остановке нужен Shutdown
+
+	elapsed    time.Duration // Игровое время с прошлого выполнения (deltaTime системы)
+	budget     time.Duration // Накопитель для частоты в Hz
+	ticksSince int
+	runs       uint64
+
+	watch systemWatch // Нарушения и карантин, если включен сторож
+}
+
+// advance учитывает тик с deltaTime и сообщает, нужно ли выполнить систему
+// и с каким deltaTime. Пропущенные тики суммируются в deltaTime следующего выполнения.
+func (s *systemState) advance(deltaTime time.Duration) (time.Duration, bool) {
+	if !s.enabled {
+		return 0, false
+	}
+
+	s.elapsed += deltaTime
+	s.ticksSince++
+
+	switch {
+	case s.rate.Hz > 0:
+		interval := time.Duration(float64(time.Second) / s.rate.Hz)
+		s.budget += deltaTime
+		if s.budget < interval {
+			return 0, false
+		}
+		// Остаток переносится, чтобы средняя частота не зависела от дрожания тиков
+		s.budget -= interval
+		if s.budget >= interval {
+			s.budget = 0
+		}
+	case s.rate.EveryTicks > 1:
+		if s.ticksSince < s.rate.EveryTicks {
+			return 0, false
+		}
+	}
+
+	delta := s.elapsed
+	s.elapsed = 0
+	s.ticksSince = 0
+	s.runs++
+	return delta, true
+}
+
+// postpone возвращает deltaTime невыполненного запуска: он войдет в следующее выполнение
+func (s *systemState) postpone(deltaTime time.Duration) {
+	s.elapsed += deltaTime
+}
+
+// reset начинает отсчет расписания заново
+func (s *systemState) reset() {
+	s.elapsed = 0
+	s.budget = 0
+	s.ticksSince = 0
+}
+
+// SystemInfo расписание и метрики системы для списка систем
+type SystemInfo struct {
+	Name       string  `json:"name"`
+	Priority   int     `json:"priority"`
+	Enabled    bool    `json:"enabled"`
+	EveryTicks int     `json:"every_ticks,omitempty"`
+	Hz         float64 `json:"hz,omitempty"`
+	Runs       uint64  `json:"runs"`
+
+	LastExecutionTime time.Duration `json:"last_execution_time"`
+	AverageTime       time.Duration `json:"average_time"`
+	MaxTime           time.Duration `json:"max_time"`
+	Errors            uint64        `json:"errors"`
+
+	QuarantinedUntil *time.Time `json:"quarantined_until,omitempty"`
+	QuarantineLevel  int        `json:"quarantine_level,omitempty"`
+	Hung             bool       `json:"hung,omitempty"`
+}
+
+// SetSystemRate меняет частоту выполнения системы
+func (gt *GameTicker) SetSystemRate(name string, rate SystemRate) error {
+	if err := rate.Validate(); err != nil {
+		return err
+	}
+
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	state, ok := gt.schedules[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrSystemNotFound, name)
+	}
+	state.rate = rate
+	state.reset()
+
+	gt.logger.Printf("[GameTicker] Частота системы %s: %s", name, rate)
+	return nil
+}
+
+// SetSystemEnabled включает или выключает систему. Выключенная система
+// не выполняется, а после включения получает deltaTime одного тика.
+func (gt *GameTicker) SetSystemEnabled(name string, enabled bool) error {
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	state, ok := gt.schedules[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrSystemNotFound, name)
+	}
+	if state.enabled == enabled {
+		return nil
+	}
+	state.enabled = enabled
+	state.reset()
+
+	if enabled {
+		gt.logger.Printf("[GameTicker] Система %s включена", name)
+	} else {
+		gt.logger.Printf("[GameTicker] Система %s выключена", name)
+	}
+	return nil
+}
+
+// UnregisterSystem удаляет систему из игрового цикла
+func (gt *GameTicker) UnregisterSystem(name string) error {
+	gt.systemsMutex.Lock()
+	state, ok := gt.schedules[name]
+	if !ok {
+		gt.systemsMutex.Unlock()
+		return fmt.Errorf("%w: %s", ErrSystemNotFound, name)
+	}
+
+	var removed TickSystem
+	for i, system := range gt.systems {
+		if system.GetName() == name {
+			removed = system
+			gt.systems = append(gt.systems[:i], gt.systems[i+1:]...)
+			break
+		}
+	}
+	delete(gt.schedules, name)
+	gt.perfMonitor.removeSystemMetrics(name)
+	gt.rebuildPlan()
+	gt.systemsMutex.Unlock()
+
+	gt.logger.Printf("[GameTicker] Система удалена: %s", name)
+
+	// Удаленная на ходу система освобождает ресурсы сразу
+	if state.initialized {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultShutdownTimeout)
+		defer cancel()
+		return gt.shutdownSystem(ctx, removed)
+	}
+	return nil
+}
+
+// ListSystems возвращает зарегистрированные системы в порядке приоритета
+// с их расписанием и метриками
+func (gt *GameTicker) ListSystems() []SystemInfo {
+	gt.systemsMutex.RLock()
+	defer gt.systemsMutex.RUnlock()
+
+	infos := make([]SystemInfo, 0, len(gt.systems))
+	for _, system := range gt.systems {
+		state := gt.schedules[system.GetName()]
+		info := SystemInfo{
+			Name:       system.GetName(),
+			Priority:   system.GetPriority(),
+			Enabled:    state.enabled,
+			EveryTicks: state.rate.EveryTicks,
+			Hz:         state.rate.Hz,
+			Runs:       state.runs,
+
+			QuarantineLevel: state.watch.level,
+			Hung:            state.watch.hung,
+		}
+		if state.watch.quarantined() {
+			until := state.watch.quarantinedUntil
+			info.QuarantinedUntil = &until
+		}
+		if metrics, ok := gt.perfMonitor.snapshot(system.GetName()); ok {
+			info.LastExecutionTime = metrics.LastExecutionTime
+			info.AverageTime = metrics.AverageTime
+			info.MaxTime = metrics.MaxTime
+			info.Errors = metrics.Errors
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
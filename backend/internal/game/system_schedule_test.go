@@ -0,0 +1,112 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"os"
+	"testing"
+	"time"
+)
+
+// deltaRecorder тестовая система, запоминающая deltaTime каждого выполнения
+type deltaRecorder struct {
+	name   string
+	deltas []time.Duration
+}
+
+func (s *deltaRecorder) Update(deltaTime time.Duration) error {
+	s.deltas = append(s.deltas, deltaTime)
+	return nil
+}
+
+func (s *deltaRecorder) GetName() string  { return s.name }
+func (s *deltaRecorder) GetPriority() int { return 1 }
+
+func TestGameTicker_SystemRates(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	gameTicker.EnableFixedTimestep(DefaultMaxCatchUpTicks)
+	gameTicker.SetSystemWorkers(1)
+
+	everyTick := &deltaRecorder{name: "every"}
+	everyThird := &deltaRecorder{name: "third"}
+	fiveHz := &deltaRecorder{name: "five"}
+	for _, system := range []*deltaRecorder{everyTick, everyThird, fiveHz} {
+		gameTicker.RegisterSystem(system)
+	}
+
+	if err := gameTicker.SetSystemRate("third", SystemRate{EveryTicks: 3}); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	if err := gameTicker.SetSystemRate("five", SystemRate{Hz: 5}); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	if err := gameTicker.SetSystemRate("five", SystemRate{EveryTicks: 2, Hz: 5}); err == nil {
+		t.Error("Ожидали ошибку при одновременном задании тиков и Hz")
+	}
+	if err := gameTicker.SetSystemRate("missing", SystemRate{}); !errors.Is(err, ErrSystemNotFound) {
+		t.Errorf("Ожидали ErrSystemNotFound, получили %v", err)
+	}
+
+	gameTicker.Pause()
+	if _, err := gameTicker.Step(12); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+
+	tick := 50 * time.Millisecond
+	if len(everyTick.deltas) != 12 || everyTick.deltas[0] != tick {
+		t.Errorf("Система без ограничений: ожидали 12 выполнений по %v, получили %v", tick, everyTick.deltas)
+	}
+	// Пропущенные тики суммируются в deltaTime выполнения
+	if len(everyThird.deltas) != 4 || everyThird.deltas[0] != 3*tick {
+		t.Errorf("Раз в 3 тика: ожидали 4 выполнения по %v, получили %v", 3*tick, everyThird.deltas)
+	}
+	if len(fiveHz.deltas) != 3 || fiveHz.deltas[0] != 200*time.Millisecond {
+		t.Errorf("5 Hz: ожидали 3 выполнения по 200ms, получили %v", fiveHz.deltas)
+	}
+
+	// Выключенная система не выполняется и после включения получает один тик
+	if err := gameTicker.SetSystemEnabled("every", false); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	gameTicker.Step(5)
+	if len(everyTick.deltas) != 12 {
+		t.Errorf("Выключенная система выполнилась: %d", len(everyTick.deltas))
+	}
+	gameTicker.SetSystemEnabled("every", true)
+	gameTicker.Step(1)
+	if last := everyTick.deltas[len(everyTick.deltas)-1]; last != tick {
+		t.Errorf("После включения ожидали deltaTime %v, получили %v", tick, last)
+	}
+
+	if err := gameTicker.UnregisterSystem("third"); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	runs := len(everyThird.deltas)
+	gameTicker.Step(6)
+	if len(everyThird.deltas) != runs {
+		t.Error("Удаленная система не должна выполняться")
+	}
+
+	infos := gameTicker.ListSystems()
+	if len(infos) != 2 || infos[0].Name != "every" || infos[1].Name != "five" {
+		t.Fatalf("Неверный список систем: %+v", infos)
+	}
+	if infos[1].Hz != 5 || infos[1].Runs != uint64(len(fiveHz.deltas)) || !infos[0].Enabled {
+		t.Errorf("Неверное расписание в списке: %+v", infos)
+	}
+}
+
+func TestGameTicker_DefaultRate(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	gameTicker.RegisterSystem(NewPhysicsUpdateSystem(nil, gameTicker, logger))
+
+	// Повторная регистрация с тем же именем игнорируется
+	gameTicker.RegisterSystem(NewPhysicsUpdateSystem(nil, gameTicker, logger))
+
+	infos := gameTicker.ListSystems()
+	if len(infos) != 1 || infos[0].Hz != 20 {
+		t.Errorf("Ожидали одну систему с частотой 20 Hz из DefaultRate, получили %+v", infos)
+	}
+}
@@ -1,8 +1,9 @@
 package game
 
 import (
-	"context"
+	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
 	pb "x-cells/backend/internal/physics/generated"
@@ -35,11 +36,8 @@ func (wus *WorldUpdateSystem) Update(deltaTime time.Duration) error {
 	// Здесь можно добавить логику обновления объектов мира
 	// Например, движение еды, анимации и т.д.
 
-	// Логируем состояние каждые 30 секунд
-	if wus.gameTicker.GetTickCount()%600 == 0 { // Каждые 30 секунд при 20 TPS
-		wus.logger.Printf("[WorldUpdateSystem] Обновление мира: объектов %d, игроков %d",
-			len(wus.worldManager.GetAllObjects()), len(wus.gameTicker.GetAllPlayers()))
-	}
+	wus.logger.Printf("[WorldUpdateSystem] Обновление мира: объектов %d, игроков %d",
+		len(wus.worldManager.GetAllObjects()), Count[PlayerState](wus.gameTicker.Entities()))
 
 	return nil
 }
@@ -54,8 +52,15 @@ func (wus *WorldUpdateSystem) GetPriority() int {
 	return wus.priority
 }
 
+// DefaultRate обновляет мир раз в 30 секунд: пока система только выводит его состояние
+func (wus *WorldUpdateSystem) DefaultRate() SystemRate {
+	return SystemRate{Hz: 1.0 / 30}
+}
+
 // PlayerManagementSystem система управления игроками
 type PlayerManagementSystem struct {
+	SystemClock
+
 	name         string
 	priority     int
 	gameTicker   *GameTicker
@@ -81,15 +86,13 @@ func NewPlayerManagementSystem(gameTicker *GameTicker, worldManager *world.Manag
 // Update обновляет состояние игроков
 func (pms *PlayerManagementSystem) Update(deltaTime time.Duration) error {
 	// Проверяем неактивных игроков
-	now := time.Now()
-	players := pms.gameTicker.GetAllPlayers()
-
+	now := pms.Now()
 	var toRemove []string
-	for playerID, player := range players {
-		if now.Sub(player.LastSeen) > pms.inactiveTimeout {
-			toRemove = append(toRemove, playerID)
+	Each(pms.gameTicker.Entities(), func(_ Entity, state *PlayerState) {
+		if now.Sub(state.LastSeen) > pms.inactiveTimeout {
+			toRemove = append(toRemove, state.ID)
 		}
-	}
+	})
 
 	// Удаляем неактивных игроков
 	for _, playerID := range toRemove {
@@ -117,7 +120,6 @@ type PhysicsUpdateSystem struct {
 	physicsClient transport.IPhysicsClient
 	gameTicker    *GameTicker
 	logger        *log.Logger
-	lastUpdate    time.Time
 }
 
 // NewPhysicsUpdateSystem создает новую систему обновления физики
@@ -128,19 +130,11 @@ func NewPhysicsUpdateSystem(physicsClient transport.IPhysicsClient, gameTicker *
 		physicsClient: physicsClient,
 		gameTicker:    gameTicker,
 		logger:        logger,
-		lastUpdate:    time.Now(),
 	}
 }
 
 // Update обновляет физику
 func (pus *PhysicsUpdateSystem) Update(deltaTime time.Duration) error {
-	// Обновляем физику каждые несколько тиков для производительности
-	now := time.Now()
-	if now.Sub(pus.lastUpdate) < 50*time.Millisecond { // Максимум 20 FPS для физики
-		return nil
-	}
-	pus.lastUpdate = now
-
 	// Здесь можно добавить логику обновления физики
 	// Например, синхронизация позиций игроков с bullet-server
 
@@ -157,21 +151,30 @@ func (pus *PhysicsUpdateSystem) GetPriority() int {
 	return pus.priority
 }
 
+// DefaultRate ограничивает обновление физики 20 раз в секунду
+func (pus *PhysicsUpdateSystem) DefaultRate() SystemRate {
+	return SystemRate{Hz: 20}
+}
+
 // PhysicsPositionSyncSystem система синхронизации позиций игроков из физического движка с GameTicker
 type PhysicsPositionSyncSystem struct {
+	SystemContext
+
 	name          string
 	priority      int
 	physicsClient transport.IPhysicsClient
 	gameTicker    *GameTicker
 	objectManager ObjectManager
 	logger        *log.Logger
-	lastSync      time.Time
-	syncInterval  time.Duration
+
+	// Ошибки запросов состояния с прошлой сводки: выводятся в LogStats, а не на каждом тике
+	syncErrors    atomic.Uint64
+	lastSyncError atomic.Value // string
 }
 
 // ObjectManager интерфейс для получения объектов игроков
 type ObjectManager interface {
-	GetAllWorldObjects() []*world.WorldObject
+	GetByKind(kind world.ObjectKind) []*world.WorldObject
 }
 
 // NewPhysicsPositionSyncSystem создает новую систему синхронизации позиций
@@ -183,65 +186,41 @@ func NewPhysicsPositionSyncSystem(physicsClient transport.IPhysicsClient, gameTi
 		gameTicker:    gameTicker,
 		objectManager: objectManager,
 		logger:        logger,
-		lastSync:      time.Now(),
-		syncInterval:  100 * time.Millisecond, // Синхронизируем каждые 100мс (10 FPS)
 	}
 }
 
 // Update синхронизирует позиции игроков
 func (ppss *PhysicsPositionSyncSystem) Update(deltaTime time.Duration) error {
-	// Ограничиваем частоту синхронизации
-	now := time.Now()
-	if now.Sub(ppss.lastSync) < ppss.syncInterval {
-		return nil
-	}
-	ppss.lastSync = now
-
 	// Получаем всех игроков из GameTicker
 	players := ppss.gameTicker.GetAllPlayers()
 	if len(players) == 0 {
 		return nil
 	}
 
-	// Получаем все объекты мира
-	worldObjects := ppss.objectManager.GetAllWorldObjects()
-
-	// Создаем карту objectID -> playerID для поиска
-	objectToPlayer := make(map[string]string)
-	for _, obj := range worldObjects {
-		// Объекты игроков обычно содержат "player" в ID
-		if len(obj.ID) > 0 && obj.PhysicsType != world.PhysicsTypeAmmo {
-			// Ищем игрока, которому принадлежит этот объект
-			for playerID := range players {
-				// Простая эвристика: если objectID содержит playerID
-				if len(playerID) > 10 && len(obj.ID) > 10 {
-					// Объекты игроков имеют формат вроде "player_timestamp_objectnum"
-					// playerID имеет формат вроде "player_timestamp"
-					objectToPlayer[obj.ID] = playerID
-					break
-				}
-			}
-		}
-	}
+	// Получаем объекты игроков
+	playerObjects := ppss.objectManager.GetByKind(world.KindPlayer)
 
-	// Логируем периодически для отладки
-	if ppss.gameTicker.GetTickCount()%200 == 0 { // Каждые 10 секунд при 20 TPS
-		ppss.logger.Printf("[PhysicsPositionSync] Синхронизация: игроков %d, объектов %d, связей %d",
-			len(players), len(worldObjects), len(objectToPlayer))
+	// Создаем карту objectID -> playerID по владельцу объекта
+	objectToPlayer := make(map[string]string, len(playerObjects))
+	for _, obj := range playerObjects {
+		if obj.PhysicsType == world.PhysicsTypeAmmo {
+			continue // Позиция есть только на клиенте
+		}
+		if _, exists := players[obj.OwnerID]; exists {
+			objectToPlayer[obj.ID] = obj.OwnerID
+		}
 	}
 
 	// Синхронизируем позиции из физического движка
 	for objectID, playerID := range objectToPlayer {
 		// Получаем состояние объекта из физического движка
-		resp, err := ppss.physicsClient.GetObjectState(context.Background(), &pb.GetObjectStateRequest{
+		resp, err := ppss.physicsClient.GetObjectState(ppss.Context(), &pb.GetObjectStateRequest{
 			Id: objectID,
 		})
 
 		if err != nil {
-			// Логируем ошибки только периодически, чтобы не спамить
-			if ppss.gameTicker.GetTickCount()%400 == 0 {
-				ppss.logger.Printf("[PhysicsPositionSync] Ошибка получения состояния объекта %s: %v", objectID, err)
-			}
+			ppss.syncErrors.Add(1)
+			ppss.lastSyncError.Store(fmt.Sprintf("объект %s: %v", objectID, err))
 			continue
 		}
 
@@ -272,6 +251,30 @@ func (ppss *PhysicsPositionSyncSystem) GetPriority() int {
 	return ppss.priority
 }
 
+// LogStats выводит состояние синхронизации и ошибки с прошлой сводки (по расписанию StatsLogSystem)
+func (ppss *PhysicsPositionSyncSystem) LogStats() {
+	ppss.logger.Printf("[PhysicsPositionSync] Синхронизация: игроков %d, объектов %d",
+		Count[PlayerState](ppss.gameTicker.Entities()), len(ppss.objectManager.GetByKind(world.KindPlayer)))
+
+	if count := ppss.syncErrors.Swap(0); count > 0 {
+		lastError, _ := ppss.lastSyncError.Load().(string)
+		ppss.logger.Printf("[PhysicsPositionSync] Ошибок получения состояния: %d, последняя: %s", count, lastError)
+	}
+}
+
+// DefaultRate синхронизирует позиции 10 раз в секунду
+func (ppss *PhysicsPositionSyncSystem) DefaultRate() SystemRate {
+	return SystemRate{Hz: 10}
+}
+
+// Access объявляет ресурсы системы для планировщика
+func (ppss *PhysicsPositionSyncSystem) Access() SystemAccess {
+	return SystemAccess{
+		Reads:  []string{ResourceWorld, ResourcePhysics},
+		Writes: []string{ResourcePlayers},
+	}
+}
+
 // WebSocketBroadcaster интерфейс для отправки обновлений клиентам
 type WebSocketBroadcaster interface {
 	BroadcastGameState(gameState map[string]interface{}) error
@@ -279,15 +282,13 @@ type WebSocketBroadcaster interface {
 
 // NetworkSyncSystem система синхронизации состояния с клиентами
 type NetworkSyncSystem struct {
-	name          string
-	priority      int
-	gameTicker    *GameTicker
-	worldManager  *world.Manager
-	logger        *log.Logger
-	lastBroadcast time.Time
+	SystemClock
 
-	// Буфер для оптимизации отправки
-	broadcastInterval time.Duration
+	name         string
+	priority     int
+	gameTicker   *GameTicker
+	worldManager *world.Manager
+	logger       *log.Logger
 
 	// Ссылка на систему еды для получения данных
 	foodSystem *FoodSystem
@@ -299,12 +300,11 @@ type NetworkSyncSystem struct {
 // NewNetworkSyncSystem создает новую систему сетевой синхронизации
 func NewNetworkSyncSystem(gameTicker *GameTicker, worldManager *world.Manager, logger *log.Logger) *NetworkSyncSystem {
 	return &NetworkSyncSystem{
-		name:              "NetworkSyncSystem",
-		priority:          100, // Самый низкий приоритет - отправляем в конце тика
-		gameTicker:        gameTicker,
-		worldManager:      worldManager,
-		logger:            logger,
-		broadcastInterval: 50 * time.Millisecond, // 20 FPS для клиентов
+		name:         "NetworkSyncSystem",
+		priority:     100, // Самый низкий приоритет - отправляем в конце тика
+		gameTicker:   gameTicker,
+		worldManager: worldManager,
+		logger:       logger,
 	}
 }
 
@@ -320,12 +320,7 @@ func (nss *NetworkSyncSystem) SetWebSocketServer(wsServer WebSocketBroadcaster)
 
 // Update отправляет обновления состояния клиентам
 func (nss *NetworkSyncSystem) Update(deltaTime time.Duration) error {
-	// Ограничиваем частоту отправки
-	now := time.Now()
-	if now.Sub(nss.lastBroadcast) < nss.broadcastInterval {
-		return nil
-	}
-	nss.lastBroadcast = now
+	now := nss.Now()
 
 	// Получаем всех игроков
 	players := nss.gameTicker.GetAllPlayers()
@@ -336,15 +331,6 @@ func (nss *NetworkSyncSystem) Update(deltaTime time.Duration) error {
 		foodItems = nss.foodSystem.GetFoodItems()
 	}
 
-	// Логируем периодически для отладки
-	if nss.gameTicker.GetTickCount()%400 == 0 { // Каждые 20 секунд при 20 TPS
-		foodCount := 0
-		if foodItems != nil {
-			foodCount = len(foodItems)
-		}
-		nss.logger.Printf("[NetworkSyncSystem] Синхронизация: игроков %d, еды %d", len(players), foodCount)
-	}
-
 	// Отправляем обновления через WebSocket (если сервер настроен)
 	if nss.wsServer != nil && (len(players) > 0 || (foodItems != nil && len(foodItems) > 0)) {
 		gameState := map[string]interface{}{
@@ -373,6 +359,21 @@ func (nss *NetworkSyncSystem) GetPriority() int {
 	return nss.priority
 }
 
+// LogStats выводит объем синхронизации (по расписанию StatsLogSystem)
+func (nss *NetworkSyncSystem) LogStats() {
+	foodCount := 0
+	if nss.foodSystem != nil {
+		foodCount = nss.foodSystem.foodCount()
+	}
+	nss.logger.Printf("[NetworkSyncSystem] Синхронизация: игроков %d, еды %d",
+		Count[PlayerState](nss.gameTicker.Entities()), foodCount)
+}
+
+// DefaultRate отправляет состояние клиентам 20 раз в секунду
+func (nss *NetworkSyncSystem) DefaultRate() SystemRate {
+	return SystemRate{Hz: 20}
+}
+
 // GameMetricsSystem система сбора игровых метрик
 type GameMetricsSystem struct {
 	name         string
@@ -380,32 +381,21 @@ type GameMetricsSystem struct {
 	gameTicker   *GameTicker
 	worldManager *world.Manager
 	logger       *log.Logger
-
-	// Счетчики для метрик
-	lastMetricsLog  time.Time
-	metricsInterval time.Duration
 }
 
 // NewGameMetricsSystem создает новую систему сбора метрик
 func NewGameMetricsSystem(gameTicker *GameTicker, worldManager *world.Manager, logger *log.Logger) *GameMetricsSystem {
 	return &GameMetricsSystem{
-		name:            "GameMetricsSystem",
-		priority:        200, // Очень низкий приоритет - метрики в самом конце
-		gameTicker:      gameTicker,
-		worldManager:    worldManager,
-		logger:          logger,
-		metricsInterval: 30 * time.Second, // Логируем метрики каждые 30 секунд
+		name:         "GameMetricsSystem",
+		priority:     200, // Очень низкий приоритет - метрики в самом конце
+		gameTicker:   gameTicker,
+		worldManager: worldManager,
+		logger:       logger,
 	}
 }
 
 // Update собирает и логирует игровые метрики
 func (gms *GameMetricsSystem) Update(deltaTime time.Duration) error {
-	now := time.Now()
-	if now.Sub(gms.lastMetricsLog) < gms.metricsInterval {
-		return nil
-	}
-	gms.lastMetricsLog = now
-
 	// Собираем метрики
 	stats := gms.gameTicker.GetStats()
 	players := gms.gameTicker.GetAllPlayers()
@@ -432,3 +422,8 @@ func (gms *GameMetricsSystem) GetName() string {
 func (gms *GameMetricsSystem) GetPriority() int {
 	return gms.priority
 }
+
+// DefaultRate логирует метрики раз в 30 секунд
+func (gms *GameMetricsSystem) DefaultRate() SystemRate {
+	return SystemRate{Hz: 1.0 / 30}
+}
@@ -0,0 +1,45 @@
+package game
+
+import (
+	"context"
+	"log"
+
+	"x-cells/backend/internal/world"
+)
+
+// TerrainDeformer изменяет рельеф во время игры: применяет кисть к террейну мира
+// и передает измененный участок в Bullet. Клиентам участок рассылается через
+// событие TerrainPatched, которое публикует вызвавшая деформацию система.
+type TerrainDeformer struct {
+	worldManager *world.Manager
+	logger       *log.Logger
+}
+
+// NewTerrainDeformer создает объект для изменения рельефа
+func NewTerrainDeformer(worldManager *world.Manager, logger *log.Logger) *TerrainDeformer {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &TerrainDeformer{
+		worldManager: worldManager,
+		logger:       logger,
+	}
+}
+
+// Deform применяет кисть к террейну. Второе значение false, если террейн не изменился.
+// ctx используется для вызова Bullet. Ошибка Bullet не отменяет изменение: высоты в мире
+// и у клиентов остаются согласованными, а расхождение с серверной физикой попадает в лог.
+func (td *TerrainDeformer) Deform(ctx context.Context, brush world.TerrainBrush) (world.TerrainPatch, bool) {
+	patch, ok := td.worldManager.DeformTerrain(brush)
+	if !ok {
+		return patch, false
+	}
+
+	if factory := td.worldManager.GetFactory(); factory != nil {
+		if err := factory.UpdateTerrainInBullet(ctx, patch); err != nil {
+			td.logger.Printf("[TerrainDeformer] Ошибка обновления террейна %s в Bullet: %v", patch.TerrainID, err)
+		}
+	}
+	return patch, true
+}
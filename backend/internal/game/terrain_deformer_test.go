@@ -0,0 +1,35 @@
+package game
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"x-cells/backend/internal/world"
+)
+
+func TestTerrainDeformer_ReturnsChangedPatch(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	manager := world.NewManager()
+	manager.AddWorldObject(world.NewTerrain("terrain", world.Vector3{}, make([]float32, 25), 5, 5, 1, 1, 1, -10, 10))
+
+	deformer := NewTerrainDeformer(manager, logger)
+
+	// Воронка в центре
+	patch, ok := deformer.Deform(context.Background(), world.TerrainBrush{X: 0, Z: 0, Radius: 2, Delta: -3})
+	if !ok {
+		t.Fatal("Кисть в центре должна изменить террейн")
+	}
+	if patch.TerrainID != "terrain" || patch.Width == 0 || patch.Depth == 0 {
+		t.Errorf("Неверное изменение: %+v", patch)
+	}
+	// Кисть мимо террейна ничего не меняет
+	if _, ok := deformer.Deform(context.Background(), world.TerrainBrush{X: 50, Z: 50, Radius: 2, Delta: -3}); ok {
+		t.Error("Кисть мимо террейна не должна менять его")
+	}
+
+	if height, _ := manager.GroundHeightAt(0, 0); height >= 0 {
+		t.Errorf("В центре воронки высота должна быть ниже нуля, получили %.2f", height)
+	}
+}
@@ -0,0 +1,160 @@
+package game
+
+import (
+	"log"
+	"math"
+	"time"
+
+	"x-cells/backend/internal/world"
+)
+
+// groundContactTolerance зазор между низом шара и землей, при котором игрок считается на земле
+const groundContactTolerance = 0.5
+
+// TerrainImpactConfig условия, при которых падение игрока оставляет воронку
+type TerrainImpactConfig struct {
+	// MinSpeed минимальная скорость падения в момент касания земли; 0 отключает воронки
+	MinSpeed float64
+	// DepthPerSpeed глубина воронки на единицу скорости сверх MinSpeed
+	DepthPerSpeed float64
+	// MaxDepth наибольшая глубина одной воронки
+	MaxDepth float64
+	// RadiusScale радиус воронки в радиусах игрока
+	RadiusScale float64
+}
+
+// DefaultTerrainImpactConfig возвращает условия по умолчанию
+func DefaultTerrainImpactConfig() TerrainImpactConfig {
+	return TerrainImpactConfig{
+		MinSpeed:      15,
+		DepthPerSpeed: 0.05,
+		MaxDepth:      2,
+		RadiusScale:   1.5,
+	}
+}
+
+// impactSample последняя известная высота игрока
+type impactSample struct {
+	y        float64
+	at       time.Time
+	airborne bool
+}
+
+// TerrainImpactSystem следит за приземлениями игроков: быстрое падение на террейн
+// продавливает воронку через TerrainDeformer и публикует TerrainPatched
+type TerrainImpactSystem struct {
+	SystemClock
+	SystemContext
+
+	name       string
+	priority   int
+	config     TerrainImpactConfig
+	gameTicker *GameTicker
+	deformer   *TerrainDeformer
+	logger     *log.Logger
+
+	samples map[string]impactSample
+}
+
+// NewTerrainImpactSystem создает систему воронок от приземлений
+func NewTerrainImpactSystem(gameTicker *GameTicker, deformer *TerrainDeformer, logger *log.Logger) *TerrainImpactSystem {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &TerrainImpactSystem{
+		name:       "TerrainImpactSystem",
+		priority:   28, // После поедания игроков, до контроля границ
+		config:     DefaultTerrainImpactConfig(),
+		gameTicker: gameTicker,
+		deformer:   deformer,
+		logger:     logger,
+		samples:    make(map[string]impactSample),
+	}
+}
+
+// SetConfig задает условия появления воронок
+func (tis *TerrainImpactSystem) SetConfig(config TerrainImpactConfig) {
+	tis.config = config
+}
+
+// Update сравнивает высоты игроков с прошлыми и ищет приземления
+func (tis *TerrainImpactSystem) Update(deltaTime time.Duration) error {
+	if tis.config.MinSpeed <= 0 {
+		return nil
+	}
+
+	now := tis.Now()
+	seen := make(map[string]bool)
+	Query3(tis.gameTicker.Entities(), func(_ Entity, state *PlayerState, pos *Position, radius *Radius) {
+		seen[state.ID] = true
+
+		ground, ok := tis.gameTicker.GroundHeightAt(pos.X, pos.Z)
+		if !ok {
+			delete(tis.samples, state.ID)
+			return
+		}
+		airborne := pos.Y-float64(*radius) > ground+groundContactTolerance
+
+		prev, known := tis.samples[state.ID]
+		// Позиции приходят из физики реже тиков: скорость считаем только по новым данным
+		if known && prev.y == pos.Y {
+			return
+		}
+		tis.samples[state.ID] = impactSample{y: pos.Y, at: now, airborne: airborne}
+
+		if !known || !prev.airborne || airborne {
+			return
+		}
+		elapsed := now.Sub(prev.at).Seconds()
+		if elapsed <= 0 {
+			return
+		}
+		if speed := (prev.y - pos.Y) / elapsed; speed >= tis.config.MinSpeed {
+			tis.impact(state.ID, pos.X, pos.Z, float64(*radius), speed)
+		}
+	})
+
+	// Забываем вышедших игроков
+	for id := range tis.samples {
+		if !seen[id] {
+			delete(tis.samples, id)
+		}
+	}
+	return nil
+}
+
+// impact продавливает воронку под приземлившимся игроком
+func (tis *TerrainImpactSystem) impact(playerID string, x, z, radius, speed float64) {
+	depth := math.Min(tis.config.MaxDepth, (speed-tis.config.MinSpeed)*tis.config.DepthPerSpeed)
+	if depth <= 0 {
+		return
+	}
+
+	brush := world.TerrainBrush{
+		X:      float32(x),
+		Z:      float32(z),
+		Radius: float32(radius * tis.config.RadiusScale),
+		Delta:  float32(-depth),
+	}
+	if patch, ok := tis.deformer.Deform(tis.Context(), brush); ok {
+		tis.gameTicker.Events().Publish(tis.name, TerrainPatched{patch})
+		tis.logger.Printf("[TerrainImpactSystem] Игрок %s приземлился со скоростью %.1f в (%.1f, %.1f), воронка %.2f",
+			playerID, speed, x, z, depth)
+	}
+}
+
+// GetName возвращает имя системы
+func (tis *TerrainImpactSystem) GetName() string {
+	return tis.name
+}
+
+// GetPriority возвращает приоритет системы
+func (tis *TerrainImpactSystem) GetPriority() int {
+	return tis.priority
+}
+
+// Access объявляет ресурсы, с которыми работает система
+func (tis *TerrainImpactSystem) Access() SystemAccess {
+	return SystemAccess{Reads: []string{ResourcePlayers}, Writes: []string{ResourceWorld, ResourcePhysics}}
+}
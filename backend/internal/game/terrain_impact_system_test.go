@@ -0,0 +1,63 @@
+package game
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"x-cells/backend/internal/world"
+)
+
+func TestTerrainImpactSystem_FastLandingDeformsTerrain(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	manager := world.NewManager()
+	manager.AddWorldObject(world.NewTerrain("terrain", world.Vector3{}, make([]float32, 21*21), 21, 21, 1, 1, 1, -10, 10))
+
+	gameTicker := NewGameTicker(20, manager, logger)
+	deformer := NewTerrainDeformer(manager, logger)
+	var patches []TerrainPatched
+	Subscribe(gameTicker.Events(), func(e TerrainPatched) { patches = append(patches, e) })
+
+	clock := NewManualClock(time.Unix(1000, 0))
+	impact := NewTerrainImpactSystem(gameTicker, deformer, logger)
+	impact.SetClock(clock)
+
+	step := func(fast, slow float64) {
+		t.Helper()
+		gameTicker.UpdatePlayerPosition("fast", Vector3{X: -5, Y: fast})
+		gameTicker.UpdatePlayerPosition("slow", Vector3{X: 5, Y: slow})
+		if err := impact.Update(100 * time.Millisecond); err != nil {
+			t.Fatalf("Ошибка обновления: %v", err)
+		}
+		gameTicker.Events().Dispatch()
+		clock.Advance(100 * time.Millisecond)
+	}
+
+	// fast падает со скоростью 30, slow - 5; оба касаются земли на третьем шаге
+	gameTicker.AddPlayerWithRadiusAndMass("fast", Vector3{X: -5, Y: 7}, 1, 10)
+	gameTicker.AddPlayerWithRadiusAndMass("slow", Vector3{X: 5, Y: 2}, 1, 10)
+	step(7, 2)
+	step(4, 1.5)
+	if len(patches) != 0 {
+		t.Fatal("В воздухе террейн не должен меняться")
+	}
+	step(1, 1)
+
+	if len(patches) != 1 || patches[0].TerrainID != "terrain" {
+		t.Fatalf("Ожидали одну воронку от быстрого падения, получили %+v", patches)
+	}
+	if height, _ := manager.GroundHeightAt(-5, 0); height >= 0 {
+		t.Errorf("Под fast должна появиться воронка, высота %.2f", height)
+	}
+	if height, _ := manager.GroundHeightAt(5, 0); height != 0 {
+		t.Errorf("Медленное приземление не должно менять террейн, высота %.2f", height)
+	}
+
+	// Стоящий на земле игрок воронок не оставляет
+	step(0.9, 1)
+	step(1, 1)
+	if len(patches) != 1 {
+		t.Errorf("Игрок на земле не должен оставлять воронки, изменений %d", len(patches))
+	}
+}
@@ -2,20 +2,21 @@ package game
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"log"
 	"math"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"x-cells/backend/internal/metrics"
+	"x-cells/backend/internal/transport"
 	"x-cells/backend/internal/world"
 )
 
-// PlayerUpdateBroadcaster интерфейс для отправки обновлений игрока клиентам
-type PlayerUpdateBroadcaster interface {
-	BroadcastPlayerSizeUpdate(playerID string, newRadius float64, newMass float64)
-}
-
 // GameTicker основной менеджер игрового цикла для x-cells проекта
 type GameTicker struct {
 	// Конфигурация
@@ -23,44 +24,65 @@ type GameTicker struct {
 	tickDuration time.Duration // Длительность одного тика
 	maxTickTime  time.Duration // Максимальное время на один тик
 
-	// Состояние
-	isRunning    bool
+	// Фиксированный шаг: системы всегда получают tickDuration, отставание догоняется
+	fixedTimestep   bool
+	maxCatchUpTicks int           // Максимум тиков за один кадр при догоне
+	accumulator     time.Duration // Накопленное, но еще не просимулированное время
+	alphaBits       atomic.Uint64 // Доля следующего тика в накопителе (float64), для интерполяции
+
+	// Состояние. Счетчики пишет игровой цикл, а читают HTTP-обработчики, поэтому они атомарные
+	isRunning    atomic.Bool
 	isPaused     bool
-	tickCount    uint64
+	pauseMutex   sync.RWMutex // Защищает isPaused: читается из HTTP-обработчиков
+	tickCount    atomic.Uint64
 	startTime    time.Time
 	lastTickTime time.Time
+	simTime      atomic.Int64 // Сумма deltaTime всех выполненных тиков, в наносекундах
+
+	// Источник игрового времени (реальное время или ручные часы в тестах)
+	clock Clock
 
 	// Компоненты игры
 	worldManager *world.Manager
-	players      map[string]*Player // Карта активных игроков
-	playersMutex sync.RWMutex
+	entities     *Entities     // Игроки и другие игровые сущности
+	events       *EventBus     // События систем, рассылаются в конце тика
+	history      *StateHistory // Снимки последних тиков для компенсации задержки
+	playersMutex sync.RWMutex  // Защищает restoredStats
 
 	// Системы
-	systems      []TickSystem
-	systemsMutex sync.RWMutex
+	systems       []TickSystem
+	systemsMutex  sync.RWMutex
+	schedules     map[string]*systemState  // Частота и включенность систем по имени
+	plan          *schedulePlan            // Граф зависимостей, перестраивается при регистрации систем
+	systemWorkers int                      // Горутин для параллельного выполнения систем
+	watchdog      *WatchdogConfig          // Сторож систем; nil - выключен
+	hungSystems   map[string]*SystemAccess // Зависшие системы, чей Update еще выполняется, и их ресурсы
 
 	// Мониторинг производительности
 	perfMonitor *PerformanceMonitor
+	tracer      *TickTracer // Трассы последних и медленных тиков
 
 	// Управление
-	ctx       context.Context
-	cancel    context.CancelFunc
-	pauseChan chan bool
+	ctx         context.Context
+	cancel      context.CancelFunc
+	controlChan chan tickCommand // Пауза, возобновление и пошаговое выполнение
+	loopDone    chan struct{}    // Закрывается, когда gameLoop завершился
 
 	// Метрики
-	averageTickTime time.Duration
-	maxObservedTick time.Duration
-	skippedTicks    uint64
+	averageTickTime atomic.Int64 // time.Duration
+	maxObservedTick atomic.Int64 // time.Duration
+	skippedTicks    atomic.Uint64
 
 	// Логирование
 	logger           *log.Logger
 	warningThreshold time.Duration
 
-	// Интерфейс для отправки обновлений игроков (новое поле)
-	playerBroadcaster PlayerUpdateBroadcaster
+	// Показатели игроков, восстановленные из сохранения (применяются при повторном подключении)
+	restoredStats map[string]PlayerStats
 }
 
-// Player представляет игрока в системе
+// Player снимок состояния игрока. Само состояние хранится в компонентах
+// сущности игрока (PlayerState, Position, Radius, Mass).
 type Player struct {
 	ID       string
 	Position Vector3
@@ -71,11 +93,49 @@ type Player struct {
 	LastSeen time.Time
 }
 
+// PlayerStats сохраняемые показатели игрока
+type PlayerStats struct {
+	Score  int64   `json:"score"`
+	Mass   float64 `json:"mass"`
+	Radius float64 `json:"radius"`
+	Health float64 `json:"health"`
+
+	// ResumeToken секрет, который знает только клиент игрока. ID игрока публичен
+	// (это ID его объекта), поэтому показатели возвращаются только по токену.
+	ResumeToken string `json:"resume_token,omitempty"`
+}
+
 // Vector3 представляет 3D вектор
 type Vector3 struct {
 	X, Y, Z float64
 }
 
+// DefaultMaxCatchUpTicks сколько тиков подряд можно выполнить, догоняя отставание
+const DefaultMaxCatchUpTicks = 5
+
+// MaxStepTicks ограничивает количество тиков за один вызов Step
+const MaxStepTicks = 1000
+
+// ErrNotPaused возвращается из Step, если игровой цикл не на паузе
+var ErrNotPaused = errors.New("игровой цикл не на паузе")
+
+// tickCommandKind вид команды управления игровым циклом
+type tickCommandKind int
+
+const (
+	tickCommandPause tickCommandKind = iota
+	tickCommandResume
+	tickCommandStep
+	tickCommandFrame
+)
+
+// tickCommand команда управления, выполняемая в горутине игрового цикла
+type tickCommand struct {
+	kind  tickCommandKind
+	steps int
+	done  chan uint64 // Номер тика после выполнения команды
+}
+
 // TickSystem интерфейс для всех игровых систем
 type TickSystem interface {
 	Update(deltaTime time.Duration) error
@@ -92,6 +152,20 @@ type PerformanceMonitor struct {
 	metricsWindow     int           // Количество последних тиков для усреднения
 	warningThreshold  time.Duration // Порог предупреждения для системы
 	criticalThreshold time.Duration // Критический порог
+
+	// Критический путь тика: самая длинная цепочка зависимых систем
+	lastCriticalPath    time.Duration
+	averageCriticalPath time.Duration
+	maxCriticalPath     time.Duration
+	criticalPathSystems []string
+
+	// Гистограммы для эндпоинта /metrics
+	tickDurations   *metrics.Histogram
+	systemDurations *metrics.HistogramVec
+
+	// Оповещения сторожа систем
+	alerts      []Alert
+	alertCounts map[AlertKind]uint64
 }
 
 // SystemMetrics метрики производительности системы
@@ -128,16 +202,25 @@ func NewGameTicker(targetTPS int, worldManager *world.Manager, logger *log.Logge
 		targetTPS:        targetTPS,
 		tickDuration:     tickDuration,
 		maxTickTime:      maxTickTime,
+		clock:            RealClock{},
 		worldManager:     worldManager,
-		players:          make(map[string]*Player),
+		entities:         NewEntities(),
+		events:           NewEventBus(logger),
+		restoredStats:    make(map[string]PlayerStats),
 		systems:          make([]TickSystem, 0),
+		schedules:        make(map[string]*systemState),
+		hungSystems:      make(map[string]*SystemAccess),
+		plan:             sequentialPlan(nil),
+		systemWorkers:    runtime.GOMAXPROCS(0),
 		perfMonitor:      NewPerformanceMonitor(50, tickDuration/4), // Предупреждение при 25% от тика
+		tracer:           NewTickTracer(DefaultTraceCapacity),
 		ctx:              ctx,
 		cancel:           cancel,
-		pauseChan:        make(chan bool, 1),
+		controlChan:      make(chan tickCommand),
 		logger:           logger,
 		warningThreshold: tickDuration / 2, // Предупреждение при 50% от времени тика
 	}
+	ticker.SetHistoryDuration(DefaultHistoryDuration)
 
 	return ticker
 }
@@ -149,18 +232,26 @@ func NewPerformanceMonitor(windowSize int, warningThreshold time.Duration) *Perf
 		metricsWindow:     windowSize,
 		warningThreshold:  warningThreshold,
 		criticalThreshold: warningThreshold * 2,
+		tickDurations:     metrics.NewHistogram(metrics.TickBuckets),
+		systemDurations:   metrics.NewHistogramVec(metrics.TickBuckets),
 	}
 }
 
 // Start запускает игровой цикл
 func (gt *GameTicker) Start() error {
-	if gt.isRunning {
+	if gt.isRunning.Load() {
 		return nil // Уже запущен
 	}
 
-	gt.isRunning = true
-	gt.startTime = time.Now()
+	// Системы готовятся до первого тика; при ошибке цикл не запускается
+	if err := gt.initSystems(gt.ctx); err != nil {
+		return err
+	}
+
+	gt.isRunning.Store(true)
+	gt.startTime = gt.clock.Now()
 	gt.lastTickTime = gt.startTime
+	gt.loopDone = make(chan struct{})
 
 	gt.logger.Printf("[GameTicker] Запуск игрового цикла x-cells: %d TPS (тик каждые %v)",
 		gt.targetTPS, gt.tickDuration)
@@ -170,23 +261,132 @@ func (gt *GameTicker) Start() error {
 	return nil
 }
 
-// Stop останавливает игровой цикл
+// Stop останавливает игровой цикл, дожидаясь текущего тика и Shutdown систем
+// не дольше DefaultShutdownTimeout
 func (gt *GameTicker) Stop() {
-	if !gt.isRunning {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultShutdownTimeout)
+	defer cancel()
+
+	if err := gt.Shutdown(ctx); err != nil {
+		gt.logger.Printf("[GameTicker] ОШИБКА остановки: %v", err)
+	}
+}
+
+// Pause приостанавливает игровой цикл: системы не выполняются до Resume или Step
+func (gt *GameTicker) Pause() {
+	if gt.IsPaused() {
 		return
 	}
+	tick := gt.control(tickCommand{kind: tickCommandPause})
+	gt.logger.Printf("[GameTicker] Игровой цикл приостановлен на тике %d", tick)
+}
+
+// Resume возобновляет игровой цикл после паузы
+func (gt *GameTicker) Resume() {
+	if !gt.IsPaused() {
+		return
+	}
+	tick := gt.control(tickCommand{kind: tickCommandResume})
+	gt.logger.Printf("[GameTicker] Игровой цикл возобновлен на тике %d", tick)
+}
+
+// IsPaused возвращает true, если игровой цикл на паузе
+func (gt *GameTicker) IsPaused() bool {
+	gt.pauseMutex.RLock()
+	defer gt.pauseMutex.RUnlock()
+	return gt.isPaused
+}
+
+// Step выполняет n тиков на паузе и возвращает номер последнего тика.
+// Каждый шаг получает deltaTime ровно в один тик, поэтому шаги воспроизводимы.
+func (gt *GameTicker) Step(n int) (uint64, error) {
+	if n <= 0 || n > MaxStepTicks {
+		return gt.GetTickCount(), fmt.Errorf("количество шагов должно быть от 1 до %d, получено %d", MaxStepTicks, n)
+	}
+	if !gt.IsPaused() {
+		return gt.GetTickCount(), ErrNotPaused
+	}
+
+	tick := gt.control(tickCommand{kind: tickCommandStep, steps: n})
+	gt.logger.Printf("[GameTicker] Выполнено шагов: %d, текущий тик %d", n, tick)
+	return tick, nil
+}
+
+// control передает команду в игровой цикл и ждет ее выполнения.
+// Если цикл не запущен, команда выполняется в вызывающей горутине.
+func (gt *GameTicker) control(cmd tickCommand) uint64 {
+	if !gt.isRunning.Load() {
+		return gt.applyCommand(cmd)
+	}
+
+	cmd.done = make(chan uint64, 1)
+	select {
+	case gt.controlChan <- cmd:
+		return <-cmd.done
+	case <-gt.ctx.Done():
+		return gt.GetTickCount()
+	}
+}
 
-	gt.logger.Printf("[GameTicker] Остановка игрового цикла x-cells (выполнено тиков: %d)", gt.tickCount)
+// applyCommand выполняет команду управления; вызывается только из горутины игрового цикла
+func (gt *GameTicker) applyCommand(cmd tickCommand) uint64 {
+	switch cmd.kind {
+	case tickCommandPause:
+		gt.setPaused(true)
+	case tickCommandResume:
+		// Время паузы не должно попасть в deltaTime первого тика
+		gt.lastTickTime = gt.clock.Now()
+		gt.accumulator = 0
+		gt.setPaused(false)
+	case tickCommandStep:
+		for i := 0; i < cmd.steps; i++ {
+			gt.executeTick(gt.lastTickTime.Add(gt.tickDuration))
+		}
+	case tickCommandFrame:
+		gt.frame(gt.clock.Now())
+	}
+	return gt.tickCount.Load()
+}
 
-	gt.cancel()
-	gt.isRunning = false
+func (gt *GameTicker) setPaused(paused bool) {
+	gt.pauseMutex.Lock()
+	defer gt.pauseMutex.Unlock()
+	gt.isPaused = paused
 }
 
-// RegisterSystem добавляет систему в игровой цикл
+// RegisterSystem добавляет систему в игровой цикл. Имя системы должно быть уникальным:
+// по нему система включается, выключается и удаляется.
 func (gt *GameTicker) RegisterSystem(system TickSystem) {
+	if !gt.addSystem(system) || !gt.isRunning.Load() {
+		return
+	}
+
+	// Система, добавленная в работающий цикл, инициализируется сразу
+	if err := gt.initSystem(gt.ctx, system); err != nil {
+		gt.logger.Printf("[GameTicker] ОШИБКА: %v, система не добавлена", err)
+		gt.UnregisterSystem(system.GetName())
+	}
+}
+
+// addSystem добавляет систему в расписание; false, если имя уже занято
+func (gt *GameTicker) addSystem(system TickSystem) bool {
 	gt.systemsMutex.Lock()
 	defer gt.systemsMutex.Unlock()
 
+	if _, exists := gt.schedules[system.GetName()]; exists {
+		gt.logger.Printf("[GameTicker] ОШИБКА: система %s уже зарегистрирована", system.GetName())
+		return false
+	}
+
+	// Частота по умолчанию - каждый тик, если система не задала свою
+	state := &systemState{enabled: true}
+	if provider, ok := system.(RateProvider); ok {
+		if rate := provider.DefaultRate(); rate.Validate() == nil {
+			state.rate = rate
+		}
+	}
+	gt.schedules[system.GetName()] = state
+
 	// Добавляем систему
 	gt.systems = append(gt.systems, system)
 
@@ -199,16 +399,26 @@ func (gt *GameTicker) RegisterSystem(system TickSystem) {
 		}
 	}
 
+	gt.rebuildPlan()
+
+	// Передаем системе часы цикла
+	if aware, ok := system.(ClockAware); ok {
+		aware.SetClock(gt.clock)
+	}
+
 	// Инициализируем метрики для системы
 	gt.perfMonitor.initSystemMetrics(system.GetName())
 
-	gt.logger.Printf("[GameTicker] Зарегистрирована система: %s (приоритет: %d)",
-		system.GetName(), system.GetPriority())
+	gt.logger.Printf("[GameTicker] Зарегистрирована система: %s (приоритет: %d, %s)",
+		system.GetName(), system.GetPriority(), state.rate)
+	return true
 }
 
 // gameLoop основной игровой цикл
 func (gt *GameTicker) gameLoop() {
-	ticker := time.NewTicker(gt.tickDuration)
+	defer close(gt.loopDone)
+
+	ticker := gt.clock.NewTicker(gt.tickDuration)
 	defer ticker.Stop()
 
 	for {
@@ -216,64 +426,205 @@ func (gt *GameTicker) gameLoop() {
 		case <-gt.ctx.Done():
 			return
 
-		case pause := <-gt.pauseChan:
-			if pause {
-				// Ждем команды возобновления
-				for pause {
-					select {
-					case <-gt.ctx.Done():
-						return
-					case pause = <-gt.pauseChan:
-					}
-				}
+		case cmd := <-gt.controlChan:
+			cmd.done <- gt.applyCommand(cmd)
+
+		case tickTime := <-ticker.C():
+			// На паузе тики пропускаются, игра продвигается только через Step
+			if gt.IsPaused() {
+				continue
 			}
+			gt.frame(tickTime)
+		}
+	}
+}
+
+// frame обрабатывает срабатывание таймера цикла в текущем режиме шага
+func (gt *GameTicker) frame(now time.Time) {
+	if gt.fixedTimestep {
+		gt.advanceFixed(now)
+	} else {
+		gt.executeTick(now)
+	}
+}
+
+// SetClock задает источник игрового времени для цикла и всех систем. Вызывать до Start.
+func (gt *GameTicker) SetClock(clock Clock) {
+	gt.clock = clock
+	if !gt.isRunning.Load() {
+		gt.startTime = clock.Now()
+		gt.lastTickTime = gt.startTime
+	}
 
-		case tickTime := <-ticker.C:
-			gt.executeTick(tickTime)
+	gt.systemsMutex.RLock()
+	defer gt.systemsMutex.RUnlock()
+	for _, system := range gt.systems {
+		if aware, ok := system.(ClockAware); ok {
+			aware.SetClock(clock)
 		}
 	}
 }
 
-// executeTick выполняет один игровой тик
+// Clock возвращает часы игрового цикла
+func (gt *GameTicker) Clock() Clock {
+	return gt.clock
+}
+
+// Tick синхронно обрабатывает один кадр по текущему времени часов, как если бы
+// сработал таймер цикла. Вместе с ManualClock позволяет тестам прогонять тысячи
+// тиков без ожидания. На паузе кадр пропускается.
+func (gt *GameTicker) Tick() uint64 {
+	if gt.IsPaused() {
+		return gt.GetTickCount()
+	}
+	return gt.control(tickCommand{kind: tickCommandFrame})
+}
+
+// EnableFixedTimestep включает режим фиксированного шага. Вызывать до Start.
+// Системы всегда получают deltaTime, равный длительности тика; реальное время
+// копится в накопителе, и отставание догоняется не более чем maxCatchUp тиками за кадр.
+// Остаток сверх этого отбрасывается и учитывается в skipped_ticks.
+func (gt *GameTicker) EnableFixedTimestep(maxCatchUp int) {
+	if maxCatchUp <= 0 {
+		maxCatchUp = DefaultMaxCatchUpTicks
+	}
+	gt.fixedTimestep = true
+	gt.maxCatchUpTicks = maxCatchUp
+	gt.logger.Printf("[GameTicker] Фиксированный шаг %v, догон до %d тиков за кадр", gt.tickDuration, maxCatchUp)
+}
+
+// InterpolationAlpha возвращает долю следующего тика, уже накопленную в режиме
+// фиксированного шага (0..1). Позволяет интерполировать состояние между двумя тиками.
+// В обычном режиме всегда 0.
+func (gt *GameTicker) InterpolationAlpha() float64 {
+	return math.Float64frombits(gt.alphaBits.Load())
+}
+
+// advanceFixed добавляет в накопитель время с прошлого кадра и выполняет накопившиеся тики
+func (gt *GameTicker) advanceFixed(now time.Time) {
+	gt.accumulator += now.Sub(gt.lastTickTime)
+
+	ticks := 0
+	for gt.accumulator >= gt.tickDuration && ticks < gt.maxCatchUpTicks {
+		gt.runTick(gt.lastTickTime.Add(gt.tickDuration), gt.tickDuration)
+		gt.accumulator -= gt.tickDuration
+		ticks++
+	}
+
+	// Не успеваем догнать: отбрасываем отставание, чтобы не уйти в спираль
+	if behind := gt.accumulator / gt.tickDuration; behind > 0 {
+		gt.logger.Printf("[GameTicker] ПРЕДУПРЕЖДЕНИЕ: Отставание %v, отброшено тиков: %d", gt.accumulator, behind)
+		gt.skippedTicks.Add(uint64(behind))
+		gt.accumulator -= behind * gt.tickDuration
+	}
+
+	// Время кадра учтено в накопителе; следующий кадр считается от now
+	gt.lastTickTime = now
+	gt.alphaBits.Store(math.Float64bits(float64(gt.accumulator) / float64(gt.tickDuration)))
+}
+
+// executeTick выполняет один игровой тик с deltaTime по реальному времени
 func (gt *GameTicker) executeTick(tickTime time.Time) {
-	tickStart := time.Now()
 	deltaTime := tickTime.Sub(gt.lastTickTime)
 
 	// Проверяем, не слишком ли большая задержка между тиками
 	if deltaTime > gt.tickDuration*2 {
 		gt.logger.Printf("[GameTicker] ПРЕДУПРЕЖДЕНИЕ: Большая задержка между тиками: %v (ожидалось: %v)",
 			deltaTime, gt.tickDuration)
-		gt.skippedTicks++
+		gt.skippedTicks.Add(1)
 	}
 
-	gt.tickCount++
+	gt.runTick(tickTime, deltaTime)
+}
+
+// runTick выполняет системы с заданным deltaTime и обновляет метрики
+func (gt *GameTicker) runTick(tickTime time.Time, deltaTime time.Duration) {
+	// Длительность выполнения измеряется по реальному времени, а не по часам цикла
+	tickStart := time.Now()
+
+	tick := gt.tickCount.Add(1)
 	gt.lastTickTime = tickTime
+	gt.simTime.Add(int64(deltaTime))
+	gt.tracer.beginTick(tick, tickStart)
 
 	// Выполняем все системы
 	gt.executeAllSystems(deltaTime)
 
+	// Рассылаем события, опубликованные системами за тик
+	dispatchStart := time.Now()
+	if gt.events.Dispatch() > 0 {
+		gt.tracer.recordSpan("EventBus", SpanCategorySystem, dispatchStart, time.Since(dispatchStart))
+	}
+
+	// Запоминаем итог тика: таким его увидят клиенты
+	gt.recordHistory(tickTime)
+
 	// Измеряем общее время тика
 	totalTickTime := time.Since(tickStart)
+	gt.tracer.endTick(totalTickTime, totalTickTime > gt.maxTickTime)
 	gt.updateTickMetrics(totalTickTime)
 
 	// Проверяем производительность
 	gt.checkPerformance(totalTickTime)
 }
 
-// executeAllSystems выполняет все зарегистрированные системы
+// executeAllSystems выполняет все зарегистрированные системы по графу зависимостей:
+// независимые системы - параллельно, конфликтующие - в порядке приоритета.
+// Системы, которым по расписанию рано выполняться, пропускаются.
 func (gt *GameTicker) executeAllSystems(deltaTime time.Duration) {
-	gt.systemsMutex.RLock()
-	systems := make([]TickSystem, len(gt.systems))
-	copy(systems, gt.systems)
-	gt.systemsMutex.RUnlock()
+	gt.systemsMutex.Lock()
+	plan := gt.plan
+	workers := gt.systemWorkers
+	deltas := make([]time.Duration, len(plan.systems))
+	due := make([]bool, len(plan.systems))
+	now := gt.clock.Now()
+	for i, system := range plan.systems {
+		state := gt.schedules[system.GetName()]
+		// Системы в карантине не выполняются и не копят deltaTime
+		if gt.watchdog != nil && !gt.admitSystem(system.GetName(), state, now) {
+			continue
+		}
+		deltas[i], due[i] = state.advance(deltaTime)
+	}
+	gt.systemsMutex.Unlock()
+
+	// Вызовы физики из систем помечаются номером тика для трассировки
+	ctx := transport.WithTick(context.Background(), gt.tickCount.Load())
 
-	for _, system := range systems {
-		gt.executeSystem(system, deltaTime)
+	run := func(i int) time.Duration {
+		if !due[i] {
+			return 0
+		}
+		if gt.watchdog != nil {
+			// Брошенная сторожем система продолжает работать в своей горутине, поэтому
+			// системы, которые конфликтуют с ней по ресурсам, ждут ее возвращения
+			if hung, ok := gt.conflictingHung(plan.access[i]); ok {
+				gt.skipConflicting(plan.systems[i].GetName(), hung, deltas[i])
+				return 0
+			}
+			duration, _ := gt.executeWatched(ctx, plan.systems[i], plan.access[i], deltas[i])
+			return duration
+		}
+		duration, _ := gt.executeSystem(ctx, plan.systems[i], deltas[i])
+		return duration
+	}
+
+	var durations []time.Duration
+	if workers > 1 {
+		durations = gt.runPlanParallel(plan, workers, run)
+	} else {
+		durations = make([]time.Duration, len(plan.systems))
+		for _, i := range plan.order {
+			durations[i] = run(i)
+		}
 	}
+
+	gt.perfMonitor.recordCriticalPath(plan.criticalPath(durations))
 }
 
-// executeSystem выполняет одну систему с замером времени
-func (gt *GameTicker) executeSystem(system TickSystem, deltaTime time.Duration) {
+// executeSystem выполняет одну систему с замером времени. ctx передается системе
+// для вызовов физики. failed - система вернула ошибку или запаниковала.
+func (gt *GameTicker) executeSystem(ctx context.Context, system TickSystem, deltaTime time.Duration) (executionTime time.Duration, failed bool) {
 	systemStart := time.Now()
 	systemName := system.GetName()
 
@@ -281,22 +632,31 @@ func (gt *GameTicker) executeSystem(system TickSystem, deltaTime time.Duration)
 		if r := recover(); r != nil {
 			gt.logger.Printf("[GameTicker] КРИТИЧЕСКАЯ ОШИБКА в системе %s: %v", systemName, r)
 			gt.perfMonitor.recordError(systemName)
+			executionTime = time.Since(systemStart)
+			failed = true
+			gt.tracer.recordSpan(systemName, SpanCategorySystem, systemStart, executionTime)
 		}
 	}()
 
+	if aware, ok := system.(ContextAware); ok {
+		aware.SetContext(ctx)
+	}
+
 	// Выполняем систему
 	err := system.Update(deltaTime)
 
-	executionTime := time.Since(systemStart)
+	executionTime = time.Since(systemStart)
 
 	// Записываем метрики
 	gt.perfMonitor.recordExecution(systemName, executionTime)
+	gt.tracer.recordSpan(systemName, SpanCategorySystem, systemStart, executionTime)
 
 	// Обрабатываем ошибки
 	if err != nil {
 		gt.logger.Printf("[GameTicker] Ошибка в системе %s: %v", systemName, err)
 		gt.perfMonitor.recordError(systemName)
 	}
+	return executionTime, err != nil
 }
 
 // AddPlayer добавляет игрока с стандартным радиусом
@@ -313,65 +673,118 @@ func (gt *GameTicker) AddPlayerWithRadius(playerID string, pos Vector3, radius f
 
 // AddPlayerWithRadiusAndMass добавляет игрока с указанным радиусом и массой
 func (gt *GameTicker) AddPlayerWithRadiusAndMass(playerID string, pos Vector3, radius float64, mass float64) {
-	gt.playersMutex.Lock()
-	defer gt.playersMutex.Unlock()
+	id := gt.entities.Create(playerID)
+	Set(gt.entities, id, PlayerState{ID: playerID, Health: 100.0, LastSeen: gt.clock.Now()})
+	Set(gt.entities, id, Position(pos))
+	Set(gt.entities, id, Radius(radius))
+	Set(gt.entities, id, Mass(mass))
 
-	gt.players[playerID] = &Player{
-		ID:       playerID,
-		Position: pos,
-		Radius:   radius, // Используем переданный радиус
-		Mass:     mass,   // Используем переданную массу
-		Health:   100.0,
-		Score:    0,
-		LastSeen: time.Now(),
-	}
+	gt.events.Publish(EventSourceTicker, PlayerJoined{PlayerID: playerID, Position: pos, Radius: radius, Mass: mass})
 
 	gt.logger.Printf("[GameTicker] Добавлен игрок %s в позиции (%.1f, %.1f, %.1f) с радиусом %.1f и массой %.1f",
 		playerID, pos.X, pos.Y, pos.Z, radius, mass)
 }
 
 func (gt *GameTicker) RemovePlayer(playerID string) {
-	gt.playersMutex.Lock()
-	defer gt.playersMutex.Unlock()
-
-	delete(gt.players, playerID)
+	if id, ok := gt.playerEntity(playerID); ok {
+		gt.entities.Destroy(id)
+		gt.events.Publish(EventSourceTicker, PlayerLeft{PlayerID: playerID})
+	}
 	gt.logger.Printf("[GameTicker] Удален игрок %s", playerID)
 }
 
+// GetPlayer возвращает снимок игрока или nil, если игрока нет
 func (gt *GameTicker) GetPlayer(playerID string) *Player {
-	gt.playersMutex.RLock()
-	defer gt.playersMutex.RUnlock()
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return nil
+	}
 
-	return gt.players[playerID]
+	state, okState := Get[PlayerState](gt.entities, id)
+	pos, okPos := Get[Position](gt.entities, id)
+	radius, okRadius := Get[Radius](gt.entities, id)
+	mass, okMass := Get[Mass](gt.entities, id)
+	if !okState || !okPos || !okRadius || !okMass {
+		return nil // Игрок удален между чтениями
+	}
+	return newPlayerSnapshot(&state, &pos, &radius, &mass)
 }
 
-// SetPlayerBroadcaster устанавливает интерфейс для отправки обновлений игроков
-func (gt *GameTicker) SetPlayerBroadcaster(broadcaster PlayerUpdateBroadcaster) {
-	gt.playerBroadcaster = broadcaster
+// Entities возвращает хранилище игровых сущностей
+func (gt *GameTicker) Entities() *Entities {
+	return gt.entities
 }
 
-// UpdatePlayerMass безопасно обновляет массу игрока
-func (gt *GameTicker) UpdatePlayerMass(playerID string, massChange float64) {
-	gt.playersMutex.Lock()
-	defer gt.playersMutex.Unlock()
+// Events возвращает шину событий игрового цикла
+func (gt *GameTicker) Events() *EventBus {
+	return gt.events
+}
 
-	player, exists := gt.players[playerID]
-	if !exists {
+// playerEntity возвращает сущность игрока по его ID
+func (gt *GameTicker) playerEntity(playerID string) (Entity, bool) {
+	id, ok := gt.entities.Lookup(playerID)
+	if !ok || !Has[PlayerState](gt.entities, id) {
+		return 0, false
+	}
+	return id, true
+}
+
+func newPlayerSnapshot(state *PlayerState, pos *Position, radius *Radius, mass *Mass) *Player {
+	return &Player{
+		ID:       state.ID,
+		Position: Vector3(*pos),
+		Radius:   float64(*radius),
+		Health:   state.Health,
+		Score:    state.Score,
+		Mass:     float64(*mass),
+		LastSeen: state.LastSeen,
+	}
+}
+
+// GroundHeightAt возвращает высоту террейна в точке (x, z).
+// Второе значение false, если террейн недоступен или точка за его пределами.
+func (gt *GameTicker) GroundHeightAt(x, z float64) (float64, bool) {
+	if gt.worldManager == nil {
+		return 0, false
+	}
+
+	height, ok := gt.worldManager.GroundHeightAt(float32(x), float32(z))
+	return float64(height), ok
+}
+
+// UpdatePlayerMass безопасно обновляет массу игрока. ctx используется для вызова
+// Bullet: системы передают свой контекст тика.
+func (gt *GameTicker) UpdatePlayerMass(ctx context.Context, playerID string, massChange float64) {
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
 		gt.logger.Printf("[GameTicker] player %s not found for mass update", playerID)
 		return
 	}
 
-	oldMass := math.Max(player.Mass, 1.0)
-	oldRadius := player.Radius
-	player.Mass += massChange
+	var oldMass, newMass, oldRadius, newRadius float64
+	Update(gt.entities, id, func(mass *Mass) {
+		oldMass = math.Max(float64(*mass), 1.0)
+		newMass = float64(*mass) + massChange
+		*mass = Mass(newMass)
+	})
 
 	// Формула: newRadius = oldRadius * (newMass / oldMass)^(1/3)
-	massRatio := player.Mass / oldMass
-	newRadius := oldRadius * math.Pow(massRatio, 1.0/3.0)
-	player.Radius = newRadius
+	Update(gt.entities, id, func(radius *Radius) {
+		oldRadius = float64(*radius)
+		newRadius = oldRadius * math.Pow(newMass/oldMass, 1.0/3.0)
+		*radius = Radius(newRadius)
+	})
 
 	gt.logger.Printf("[GameTicker] player %s: mass %.1f->%.1f, radius %.2f->%.2f",
-		playerID, oldMass, player.Mass, oldRadius, newRadius)
+		playerID, oldMass, newMass, oldRadius, newRadius)
+
+	gt.events.Publish(EventSourceTicker, MassChanged{
+		PlayerID:  playerID,
+		OldMass:   oldMass,
+		NewMass:   newMass,
+		OldRadius: oldRadius,
+		NewRadius: newRadius,
+	})
 
 	// Синхронизация с Bullet Physics
 	if gt.worldManager == nil {
@@ -384,114 +797,118 @@ func (gt *GameTicker) UpdatePlayerMass(playerID string, massChange float64) {
 	}
 
 	gt.logger.Printf("[GameTicker] updating bullet physics for player ID: %s", playerID)
-	err := factory.UpdateObjectMassAndRadiusInBullet(playerID, float32(player.Mass), float32(newRadius))
+	err := factory.UpdateObjectMassAndRadiusInBullet(ctx, playerID, float32(newMass), float32(newRadius))
 	if err != nil {
 		gt.logger.Printf("[GameTicker] bullet physics update failed for %s: %v", playerID, err)
-		return
 	}
-
-	// Отправляем обновление клиентам
-	if math.Abs(newRadius-oldRadius) <= 0.1 || gt.playerBroadcaster == nil {
-		return
-	}
-
-	gt.playerBroadcaster.BroadcastPlayerSizeUpdate(playerID, newRadius, player.Mass)
 }
 
 // UpdatePlayerRadius безопасно обновляет радиус игрока напрямую (для внешних систем)
 func (gt *GameTicker) UpdatePlayerRadius(playerID string, newRadius float64) {
-	gt.playersMutex.Lock()
-	defer gt.playersMutex.Unlock()
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return
+	}
 
-	if player, exists := gt.players[playerID]; exists {
-		oldRadius := player.Radius
-		player.Radius = newRadius
+	var oldRadius float64
+	Update(gt.entities, id, func(radius *Radius) {
+		oldRadius = float64(*radius)
+		*radius = Radius(newRadius)
+	})
 
-		gt.logger.Printf("[GameTicker] Радиус игрока %s изменен: %.2f->%.2f",
-			playerID, oldRadius, newRadius)
-	}
+	gt.logger.Printf("[GameTicker] Радиус игрока %s изменен: %.2f->%.2f",
+		playerID, oldRadius, newRadius)
 }
 
 // GetPlayerRadius возвращает текущий радиус игрока
 func (gt *GameTicker) GetPlayerRadius(playerID string) float64 {
-	gt.playersMutex.RLock()
-	defer gt.playersMutex.RUnlock()
-
-	if player, exists := gt.players[playerID]; exists {
-		return player.Radius
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return 0.0
 	}
-	return 0.0
+	radius, _ := Get[Radius](gt.entities, id)
+	return float64(radius)
 }
 
 // UpdatePlayerPosition безопасно обновляет позицию игрока из физического движка
 func (gt *GameTicker) UpdatePlayerPosition(playerID string, newPos Vector3) {
-	gt.playersMutex.Lock()
-	defer gt.playersMutex.Unlock()
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return
+	}
 
-	if player, exists := gt.players[playerID]; exists {
-		player.Position = newPos
-		player.LastSeen = time.Now()
-		// Логируем только изменения значительных позиций (более 1 единицы)
-		oldPos := player.Position
-		distance := math.Sqrt(
-			(newPos.X-oldPos.X)*(newPos.X-oldPos.X) +
-				(newPos.Y-oldPos.Y)*(newPos.Y-oldPos.Y) +
-				(newPos.Z-oldPos.Z)*(newPos.Z-oldPos.Z),
-		)
-		if distance > 1.0 && gt.GetTickCount()%60 == 0 { // Логируем раз в 3 секунды при 20 TPS
-			gt.logger.Printf("[GameTicker] Позиция игрока %s обновлена: (%.1f, %.1f, %.1f)",
-				playerID, newPos.X, newPos.Y, newPos.Z)
+	now := gt.clock.Now()
+	Query2(gt.entities, func(entity Entity, state *PlayerState, pos *Position) {
+		if entity == id {
+			*pos = Position(newPos)
+			state.LastSeen = now
 		}
-	}
+	})
 }
 
+// GetAllPlayers возвращает снимки всех игроков. Системам, которым не нужна копия,
+// лучше обходить компоненты напрямую через Query2..Query4.
 func (gt *GameTicker) GetAllPlayers() map[string]*Player {
-	gt.playersMutex.RLock()
-	defer gt.playersMutex.RUnlock()
-
 	players := make(map[string]*Player)
-	for id, player := range gt.players {
-		// Создаем копию для безопасности
-		players[id] = &Player{
-			ID:       player.ID,
-			Position: player.Position,
-			Radius:   player.Radius,
-			Health:   player.Health,
-			Score:    player.Score,
-			Mass:     player.Mass,
-			LastSeen: player.LastSeen,
-		}
-	}
-
+	Query4(gt.entities, func(_ Entity, state *PlayerState, pos *Position, radius *Radius, mass *Mass) {
+		players[state.ID] = newPlayerSnapshot(state, pos, radius, mass)
+	})
 	return players
 }
 
 // GetStats возвращает статистику игрового цикла
 func (gt *GameTicker) GetStats() map[string]interface{} {
-	gt.playersMutex.RLock()
-	defer gt.playersMutex.RUnlock()
+	uptime := gt.clock.Now().Sub(gt.startTime)
+	tickCount := gt.tickCount.Load()
+	actualTPS := float64(tickCount) / uptime.Seconds()
 
-	uptime := time.Since(gt.startTime)
-	actualTPS := float64(gt.tickCount) / uptime.Seconds()
+	gt.systemsMutex.RLock()
+	systemsCount := len(gt.systems)
+	workers := gt.systemWorkers
+	gt.systemsMutex.RUnlock()
 
 	return map[string]interface{}{
-		"target_tps":        gt.targetTPS,
-		"actual_tps":        actualTPS,
-		"tick_count":        gt.tickCount,
-		"uptime_seconds":    uptime.Seconds(),
-		"average_tick_time": gt.averageTickTime,
-		"max_observed_tick": gt.maxObservedTick,
-		"skipped_ticks":     gt.skippedTicks,
-		"is_running":        gt.isRunning,
-		"is_paused":         gt.isPaused,
-		"systems_count":     len(gt.systems),
-		"players_count":     len(gt.players),
+		"target_tps":          gt.targetTPS,
+		"actual_tps":          actualTPS,
+		"tick_count":          tickCount,
+		"uptime_seconds":      uptime.Seconds(),
+		"average_tick_time":   time.Duration(gt.averageTickTime.Load()),
+		"max_observed_tick":   time.Duration(gt.maxObservedTick.Load()),
+		"skipped_ticks":       gt.skippedTicks.Load(),
+		"fixed_timestep":      gt.fixedTimestep,
+		"interpolation_alpha": gt.InterpolationAlpha(),
+		"simulation_seconds":  gt.GetSimulationTime().Seconds(),
+		"is_running":          gt.isRunning.Load(),
+		"is_paused":           gt.IsPaused(),
+		"systems_count":       systemsCount,
+		"system_workers":      workers,
+		"critical_path":       gt.perfMonitor.GetCriticalPathStats(),
+		"watchdog_enabled":    gt.watchdog != nil,
+		"alerts":              gt.perfMonitor.alertTotals(),
+		"players_count":       Count[PlayerState](gt.entities),
 	}
 }
 
+// GetSimulationTime возвращает игровое время: сумму deltaTime всех выполненных тиков.
+// В режиме фиксированного шага равно GetTickCount() * длительность тика.
+func (gt *GameTicker) GetSimulationTime() time.Duration {
+	return time.Duration(gt.simTime.Load())
+}
+
+// SetTracer заменяет трассировщик тиков (например, созданный заранее для
+// трассировки вызовов физики). Вызывать до Start.
+func (gt *GameTicker) SetTracer(tracer *TickTracer) {
+	gt.tracer = tracer
+}
+
+// Tracer возвращает трассировщик тиков
+func (gt *GameTicker) Tracer() *TickTracer {
+	return gt.tracer
+}
+
 // GetTickCount возвращает текущее количество тиков
 func (gt *GameTicker) GetTickCount() uint64 {
-	return gt.tickCount
+	return gt.tickCount.Load()
 }
 
 // Вспомогательные методы для мониторинга производительности
@@ -505,6 +922,33 @@ func (pm *PerformanceMonitor) initSystemMetrics(systemName string) {
 	}
 }
 
+func (pm *PerformanceMonitor) removeSystemMetrics(systemName string) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	delete(pm.systemMetrics, systemName)
+	pm.systemDurations.Delete(systemName)
+}
+
+// snapshot возвращает копию метрик системы
+func (pm *PerformanceMonitor) snapshot(systemName string) (SystemMetrics, bool) {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	metrics, exists := pm.systemMetrics[systemName]
+	if !exists {
+		return SystemMetrics{}, false
+	}
+	return SystemMetrics{
+		Name:              metrics.Name,
+		LastExecutionTime: metrics.LastExecutionTime,
+		AverageTime:       metrics.AverageTime,
+		MaxTime:           metrics.MaxTime,
+		TotalExecutions:   metrics.TotalExecutions,
+		Errors:            metrics.Errors,
+	}, true
+}
+
 func (pm *PerformanceMonitor) recordExecution(systemName string, executionTime time.Duration) {
 	pm.mutex.Lock()
 	defer pm.mutex.Unlock()
@@ -514,6 +958,8 @@ func (pm *PerformanceMonitor) recordExecution(systemName string, executionTime t
 		return
 	}
 
+	pm.systemDurations.With(systemName).ObserveDuration(executionTime)
+
 	metrics.LastExecutionTime = executionTime
 	metrics.TotalExecutions++
 
@@ -562,6 +1008,37 @@ func (pm *PerformanceMonitor) recalculateAverage(metrics *SystemMetrics) {
 	}
 }
 
+// recordCriticalPath сохраняет время критического пути последнего тика.
+// При параллельном выполнении это нижняя граница длительности тика.
+func (pm *PerformanceMonitor) recordCriticalPath(duration time.Duration, systems []string) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	pm.lastCriticalPath = duration
+	pm.criticalPathSystems = systems
+	if duration > pm.maxCriticalPath {
+		pm.maxCriticalPath = duration
+	}
+	if pm.averageCriticalPath == 0 {
+		pm.averageCriticalPath = duration
+	} else {
+		pm.averageCriticalPath = (pm.averageCriticalPath*9 + duration) / 10
+	}
+}
+
+// GetCriticalPathStats возвращает статистику критического пути
+func (pm *PerformanceMonitor) GetCriticalPathStats() map[string]interface{} {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	return map[string]interface{}{
+		"last_time":    pm.lastCriticalPath,
+		"average_time": pm.averageCriticalPath,
+		"max_time":     pm.maxCriticalPath,
+		"systems":      append([]string(nil), pm.criticalPathSystems...),
+	}
+}
+
 func (pm *PerformanceMonitor) GetSystemsStats() map[string]interface{} {
 	pm.mutex.RLock()
 	defer pm.mutex.RUnlock()
@@ -582,22 +1059,25 @@ func (pm *PerformanceMonitor) GetSystemsStats() map[string]interface{} {
 }
 
 func (gt *GameTicker) updateTickMetrics(tickTime time.Duration) {
-	if tickTime > gt.maxObservedTick {
-		gt.maxObservedTick = tickTime
+	gt.perfMonitor.tickDurations.ObserveDuration(tickTime)
+
+	// Пишет только игровой цикл, поэтому хватает Load/Store
+	if int64(tickTime) > gt.maxObservedTick.Load() {
+		gt.maxObservedTick.Store(int64(tickTime))
 	}
 
 	// Простое скользящее среднее
-	if gt.averageTickTime == 0 {
-		gt.averageTickTime = tickTime
+	if average := time.Duration(gt.averageTickTime.Load()); average == 0 {
+		gt.averageTickTime.Store(int64(tickTime))
 	} else {
-		gt.averageTickTime = (gt.averageTickTime*9 + tickTime) / 10
+		gt.averageTickTime.Store(int64((average*9 + tickTime) / 10))
 	}
 }
 
 func (gt *GameTicker) checkPerformance(tickTime time.Duration) {
 	if tickTime > gt.maxTickTime {
-		gt.logger.Printf("[GameTicker] КРИТИЧЕСКОЕ ПРЕДУПРЕЖДЕНИЕ: Тик превысил максимальное время! %v > %v (цель: %v)",
-			tickTime, gt.maxTickTime, gt.tickDuration)
+		gt.logger.Printf("[GameTicker] КРИТИЧЕСКОЕ ПРЕДУПРЕЖДЕНИЕ: Тик превысил максимальное время! %v > %v (цель: %v), трасса тика %d сохранена",
+			tickTime, gt.maxTickTime, gt.tickDuration, gt.tickCount.Load())
 	} else if tickTime > gt.warningThreshold {
 		gt.logger.Printf("[GameTicker] ПРЕДУПРЕЖДЕНИЕ: Медленный тик: %v (цель: %v)",
 			tickTime, gt.tickDuration)
@@ -623,9 +1103,121 @@ func (gt *GameTicker) AddPlayerFromWorldObject(playerID string, worldObject *wor
 	radius := float64(worldObject.Shape.Sphere.Radius)
 	mass := float64(worldObject.Shape.Sphere.Mass)
 
+	// Игрок вернулся после перезапуска сервера - восстанавливаем его размер
+	stats, restored := gt.takeRestoredStats(playerID)
+	if restored {
+		radius = stats.Radius
+		mass = stats.Mass
+		worldObject.Shape.Sphere.Radius = float32(radius)
+		worldObject.Shape.Sphere.Mass = float32(mass)
+		worldObject.Mass = float32(mass)
+
+		if gt.worldManager != nil && gt.worldManager.GetFactory() != nil {
+			factory := gt.worldManager.GetFactory()
+			if err := factory.UpdateObjectMassAndRadiusInBullet(context.Background(), playerID, float32(mass), float32(radius)); err != nil {
+				gt.logger.Printf("[GameTicker] bullet physics update failed for %s: %v", playerID, err)
+			}
+		}
+	}
+
 	// Используем существующий метод для добавления
 	gt.AddPlayerWithRadiusAndMass(playerID, position, radius, mass)
 
+	if restored {
+		if id, ok := gt.playerEntity(playerID); ok {
+			Update(gt.entities, id, func(state *PlayerState) {
+				state.Score = stats.Score
+				state.Health = stats.Health
+			})
+		}
+
+		gt.logger.Printf("[GameTicker] Игроку %s восстановлены показатели из сохранения: масса %.1f, радиус %.2f, очки %d",
+			playerID, mass, radius, stats.Score)
+	}
+
 	gt.logger.Printf("[GameTicker] Игрок %s добавлен из WorldObject с полными параметрами", playerID)
 	return nil
 }
+
+// GetPlayerStats возвращает показатели всех игроков для сохранения
+func (gt *GameTicker) GetPlayerStats() map[string]PlayerStats {
+	gt.playersMutex.RLock()
+	defer gt.playersMutex.RUnlock()
+
+	stats := make(map[string]PlayerStats, len(gt.restoredStats))
+
+	// Еще не вернувшиеся игроки сохраняются до следующего перезапуска
+	for id, s := range gt.restoredStats {
+		stats[id] = s
+	}
+	Query3(gt.entities, func(_ Entity, state *PlayerState, radius *Radius, mass *Mass) {
+		stats[state.ID] = PlayerStats{
+			Score:  state.Score,
+			Mass:   float64(*mass),
+			Radius: float64(*radius),
+			Health: state.Health,
+
+			ResumeToken: state.ResumeToken,
+		}
+	})
+
+	return stats
+}
+
+// RestorePlayerStats запоминает показатели игроков из сохранения.
+// Они применяются, когда игрок подключается снова со своим токеном возобновления.
+// Показатели без токена вернуть некому, они отбрасываются.
+func (gt *GameTicker) RestorePlayerStats(stats map[string]PlayerStats) {
+	gt.playersMutex.Lock()
+	defer gt.playersMutex.Unlock()
+
+	for id, s := range stats {
+		if s.ResumeToken == "" {
+			continue
+		}
+		gt.restoredStats[id] = s
+	}
+
+	gt.logger.Printf("[GameTicker] Восстановлены показатели %d игроков", len(gt.restoredStats))
+}
+
+// RestoredPlayerByToken возвращает ID игрока из сохранения по его токену возобновления
+func (gt *GameTicker) RestoredPlayerByToken(token string) (string, bool) {
+	if token == "" {
+		return "", false
+	}
+
+	gt.playersMutex.RLock()
+	defer gt.playersMutex.RUnlock()
+
+	for id, s := range gt.restoredStats {
+		if subtle.ConstantTimeCompare([]byte(s.ResumeToken), []byte(token)) == 1 {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// SetResumeToken задает токен, по которому игрок вернет показатели после перезапуска сервера
+func (gt *GameTicker) SetResumeToken(playerID, token string) {
+	id, ok := gt.playerEntity(playerID)
+	if !ok {
+		return
+	}
+	Update(gt.entities, id, func(state *PlayerState) {
+		state.ResumeToken = token
+	})
+}
+
+// takeRestoredStats извлекает сохраненные показатели игрока, если они есть
+func (gt *GameTicker) takeRestoredStats(playerID string) (PlayerStats, bool) {
+	gt.playersMutex.Lock()
+	defer gt.playersMutex.Unlock()
+
+	stats, ok := gt.restoredStats[playerID]
+	if !ok || stats.Radius <= 0 || stats.Mass <= 0 {
+		return PlayerStats{}, false
+	}
+	delete(gt.restoredStats, playerID)
+	return stats, true
+}
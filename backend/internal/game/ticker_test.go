@@ -0,0 +1,192 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"x-cells/backend/internal/metrics"
+)
+
+// countingSystem считает вызовы Update и запоминает последний deltaTime
+type countingSystem struct {
+	calls     atomic.Int64
+	lastDelta atomic.Int64
+}
+
+func (s *countingSystem) Update(deltaTime time.Duration) error {
+	s.calls.Add(1)
+	s.lastDelta.Store(int64(deltaTime))
+	return nil
+}
+
+func (s *countingSystem) GetName() string  { return "CountingSystem" }
+func (s *countingSystem) GetPriority() int { return 1 }
+
+func TestGameTicker_StepWithoutLoop(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	system := &countingSystem{}
+	gameTicker.RegisterSystem(system)
+
+	if _, err := gameTicker.Step(1); !errors.Is(err, ErrNotPaused) {
+		t.Fatalf("Ожидали ErrNotPaused без паузы, получили %v", err)
+	}
+
+	gameTicker.Pause()
+	tick, err := gameTicker.Step(3)
+	if err != nil {
+		t.Fatalf("Ошибка пошагового выполнения: %v", err)
+	}
+	if tick != 3 || system.calls.Load() != 3 {
+		t.Errorf("Ожидали 3 тика, получили тик %d и %d вызовов", tick, system.calls.Load())
+	}
+	// Шаг всегда равен одному тику
+	if time.Duration(system.lastDelta.Load()) != 50*time.Millisecond {
+		t.Errorf("Ожидали deltaTime 50ms, получили %v", time.Duration(system.lastDelta.Load()))
+	}
+
+	if _, err := gameTicker.Step(0); err == nil {
+		t.Error("Ожидали ошибку для нулевого количества шагов")
+	}
+	if _, err := gameTicker.Step(MaxStepTicks + 1); err == nil {
+		t.Error("Ожидали ошибку при превышении MaxStepTicks")
+	}
+}
+
+func TestGameTicker_PauseResumeRunningLoop(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(100, nil, logger)
+	system := &countingSystem{}
+	gameTicker.RegisterSystem(system)
+
+	if err := gameTicker.Start(); err != nil {
+		t.Fatalf("Ошибка запуска: %v", err)
+	}
+	defer gameTicker.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	gameTicker.Pause()
+	if !gameTicker.IsPaused() {
+		t.Fatal("Тикер должен быть на паузе")
+	}
+
+	paused := system.calls.Load()
+	time.Sleep(50 * time.Millisecond)
+	if calls := system.calls.Load(); calls != paused {
+		t.Fatalf("На паузе системы не должны выполняться: было %d, стало %d", paused, calls)
+	}
+
+	if _, err := gameTicker.Step(2); err != nil {
+		t.Fatalf("Ошибка пошагового выполнения: %v", err)
+	}
+	if calls := system.calls.Load(); calls != paused+2 {
+		t.Errorf("Ожидали %d вызовов после двух шагов, получили %d", paused+2, calls)
+	}
+
+	gameTicker.Resume()
+	time.Sleep(50 * time.Millisecond)
+	if calls := system.calls.Load(); calls <= paused+2 {
+		t.Error("После Resume системы должны снова выполняться")
+	}
+}
+
+func TestGameTicker_StatsReadableWhileRunning(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(200, nil, logger)
+	gameTicker.RegisterSystem(&countingSystem{})
+
+	if err := gameTicker.Start(); err != nil {
+		t.Fatalf("Ошибка запуска: %v", err)
+	}
+
+	// Статистику читают HTTP-обработчики, пока цикл пишет счетчики; гонки ловит -race.
+	// Читаем, пока не пройдет хотя бы несколько тиков: под нагрузкой первый тик может задержаться
+	deadline := time.Now().Add(2 * time.Second)
+	for gameTicker.GetTickCount() < 3 && time.Now().Before(deadline) {
+		stats := gameTicker.GetStats()
+		if running, _ := stats["is_running"].(bool); !running {
+			t.Fatal("Запущенный тикер должен сообщать is_running")
+		}
+		gameTicker.GetTickCount()
+		gameTicker.GetSimulationTime()
+	}
+
+	gameTicker.Stop()
+	if running, _ := gameTicker.GetStats()["is_running"].(bool); running {
+		t.Error("После Stop тикер не должен сообщать is_running")
+	}
+	if gameTicker.GetTickCount() < 3 {
+		t.Error("За время теста должны выполниться тики")
+	}
+}
+
+func TestGameTicker_FixedTimestepAccumulator(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger) // тик 50ms
+	gameTicker.EnableFixedTimestep(3)
+	system := &countingSystem{}
+	gameTicker.RegisterSystem(system)
+
+	start := time.Unix(1000, 0)
+	gameTicker.lastTickTime = start
+
+	// 125ms: два полных тика, половина следующего остается в накопителе
+	gameTicker.advanceFixed(start.Add(125 * time.Millisecond))
+	if calls := system.calls.Load(); calls != 2 {
+		t.Fatalf("Ожидали 2 тика, получили %d", calls)
+	}
+	if time.Duration(system.lastDelta.Load()) != 50*time.Millisecond {
+		t.Errorf("Системы должны получать номинальный шаг, получили %v", time.Duration(system.lastDelta.Load()))
+	}
+	if alpha := gameTicker.InterpolationAlpha(); math.Abs(alpha-0.5) > 1e-9 {
+		t.Errorf("Ожидали alpha 0.5, получили %.3f", alpha)
+	}
+
+	// Остаток накопителя переходит в следующий кадр: 125 + 25 = 150ms = 3 тика
+	gameTicker.advanceFixed(start.Add(150 * time.Millisecond))
+	if calls := system.calls.Load(); calls != 3 {
+		t.Errorf("Ожидали 3 тика с учетом остатка, получили %d", calls)
+	}
+
+	// Долгая задержка: не больше 3 тиков догона, остальное отбрасывается
+	gameTicker.advanceFixed(start.Add(1150 * time.Millisecond))
+	if calls := system.calls.Load(); calls != 6 {
+		t.Errorf("Ожидали 3 тика догона (всего 6), получили %d", calls)
+	}
+	if skipped := gameTicker.skippedTicks.Load(); skipped != 17 {
+		t.Errorf("Ожидали 17 отброшенных тиков, получили %d", skipped)
+	}
+	if gameTicker.GetSimulationTime() != 6*50*time.Millisecond {
+		t.Errorf("Игровое время должно равняться числу тиков, получили %v", gameTicker.GetSimulationTime())
+	}
+}
+
+func TestGameTicker_WriteMetrics(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	gameTicker.RegisterSystem(&countingSystem{})
+	gameTicker.Pause()
+	gameTicker.Step(3)
+
+	var b strings.Builder
+	gameTicker.WriteMetrics(metrics.NewExposition(&b))
+	out := b.String()
+
+	for _, line := range []string{
+		`xcells_tick_duration_seconds_count 3`,
+		`xcells_system_duration_seconds_count{system="CountingSystem"} 3`,
+		`xcells_system_errors_total{system="CountingSystem"} 0`,
+		`xcells_ticks_total 3`,
+		`xcells_ticker_paused 1`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("В метриках нет строки %q:\n%s", line, out)
+		}
+	}
+}
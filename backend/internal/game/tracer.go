@@ -0,0 +1,276 @@
+package game
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Настройки трассировки по умолчанию
+const (
+	DefaultTraceCapacity     = 100 // Последних тиков в кольцевом буфере
+	DefaultSlowTraceCapacity = 20  // Сохраненных медленных тиков
+)
+
+// Категории отрезков трассы
+const (
+	SpanCategoryTick   = "tick"
+	SpanCategorySystem = "system"
+	SpanCategoryRPC    = "rpc"
+)
+
+// TraceSpan отрезок времени внутри тика
+type TraceSpan struct {
+	Name     string
+	Category string
+	Start    time.Time
+	Duration time.Duration
+}
+
+// TickTrace трасса одного тика: выполнение систем и вызовы физики
+type TickTrace struct {
+	Tick     uint64
+	Start    time.Time
+	Duration time.Duration
+	Spans    []TraceSpan
+}
+
+// TickTraceInfo краткое описание сохраненной трассы
+type TickTraceInfo struct {
+	Tick     uint64        `json:"tick"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Spans    int           `json:"spans"`
+}
+
+// TickTracer записывает трассы последних тиков в кольцевой буфер и отдельно
+// сохраняет трассы медленных тиков. Реализует transport.RPCRecorder: в трассу
+// тика попадают вызовы физики, которые системы сделали с контекстом этого тика.
+type TickTracer struct {
+	mu      sync.Mutex
+	current *TickTrace
+	recent  []*TickTrace // Кольцевой буфер
+	next    int
+	filled  bool
+	slow    []*TickTrace // Медленные тики, самые старые вытесняются
+	slowCap int
+}
+
+// NewTickTracer создает трассировщик на capacity последних тиков
+func NewTickTracer(capacity int) *TickTracer {
+	if capacity <= 0 {
+		capacity = DefaultTraceCapacity
+	}
+	return &TickTracer{
+		recent:  make([]*TickTrace, capacity),
+		slowCap: DefaultSlowTraceCapacity,
+	}
+}
+
+// beginTick начинает трассу тика
+func (tt *TickTracer) beginTick(tick uint64, start time.Time) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.current = &TickTrace{Tick: tick, Start: start}
+}
+
+// recordSpan добавляет отрезок в трассу текущего тика. Вне тика ничего не делает.
+func (tt *TickTracer) recordSpan(name, category string, start time.Time, duration time.Duration) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if tt.current == nil {
+		return
+	}
+	tt.current.Spans = append(tt.current.Spans, TraceSpan{
+		Name:     name,
+		Category: category,
+		Start:    start,
+		Duration: duration,
+	})
+}
+
+// RecordRPC добавляет вызов физики в трассу тика tick. Вызовы, завершившиеся
+// после конца своего тика (например, из брошенной сторожем системы), отбрасываются.
+func (tt *TickTracer) RecordRPC(tick uint64, method string, start time.Time, duration time.Duration) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if tt.current == nil || tt.current.Tick != tick {
+		return
+	}
+	tt.current.Spans = append(tt.current.Spans, TraceSpan{
+		Name:     method,
+		Category: SpanCategoryRPC,
+		Start:    start,
+		Duration: duration,
+	})
+}
+
+// endTick завершает трассу тика и кладет ее в буфер. Трасса медленного тика
+// сохраняется отдельно и не вытесняется обычными тиками.
+func (tt *TickTracer) endTick(duration time.Duration, slow bool) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if tt.current == nil {
+		return
+	}
+
+	trace := tt.current
+	trace.Duration = duration
+	tt.current = nil
+
+	tt.recent[tt.next] = trace
+	tt.next = (tt.next + 1) % len(tt.recent)
+	if tt.next == 0 {
+		tt.filled = true
+	}
+
+	if slow {
+		tt.slow = append(tt.slow, trace)
+		if len(tt.slow) > tt.slowCap {
+			tt.slow = tt.slow[len(tt.slow)-tt.slowCap:]
+		}
+	}
+}
+
+// Recent возвращает трассы последних тиков от старых к новым
+func (tt *TickTracer) Recent() []*TickTrace {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	var traces []*TickTrace
+	if tt.filled {
+		traces = append(traces, tt.recent[tt.next:]...)
+	}
+	return append(traces, tt.recent[:tt.next]...)
+}
+
+// SlowTraces возвращает описания сохраненных медленных тиков от старых к новым
+func (tt *TickTracer) SlowTraces() []TickTraceInfo {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	infos := make([]TickTraceInfo, 0, len(tt.slow))
+	for _, trace := range tt.slow {
+		infos = append(infos, TickTraceInfo{Tick: trace.Tick, Start: trace.Start, Duration: trace.Duration, Spans: len(trace.Spans)})
+	}
+	return infos
+}
+
+// SlowTrace возвращает трассу медленного тика с номером tick
+func (tt *TickTracer) SlowTrace(tick uint64) (*TickTrace, bool) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	for _, trace := range tt.slow {
+		if trace.Tick == tick {
+			return trace, true
+		}
+	}
+	return nil, false
+}
+
+// LatestSlowTrace возвращает трассу последнего медленного тика
+func (tt *TickTracer) LatestSlowTrace() (*TickTrace, bool) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	if len(tt.slow) == 0 {
+		return nil, false
+	}
+	return tt.slow[len(tt.slow)-1], true
+}
+
+// chromeEvent событие формата Chrome trace-event (chrome://tracing, Perfetto, speedscope)
+type chromeEvent struct {
+	Name     string                 `json:"name"`
+	Category string                 `json:"cat,omitempty"`
+	Phase    string                 `json:"ph"`
+	TS       float64                `json:"ts"` // Микросекунды
+	Duration float64                `json:"dur,omitempty"`
+	PID      int                    `json:"pid"`
+	TID      int                    `json:"tid"`
+	Args     map[string]interface{} `json:"args,omitempty"`
+}
+
+// ChromeTrace преобразует трассы в JSON формата Chrome trace-event. Тик занимает
+// верхнюю дорожку, системы и вызовы физики раскладываются по дорожкам так,
+// чтобы параллельные отрезки не перекрывались.
+func ChromeTrace(traces []*TickTrace) ([]byte, error) {
+	if len(traces) == 0 {
+		return json.Marshal(map[string]interface{}{"traceEvents": []chromeEvent{}})
+	}
+
+	origin := traces[0].Start
+	micros := func(t time.Time) float64 { return float64(t.Sub(origin).Nanoseconds()) / 1e3 }
+
+	var events []chromeEvent
+	laneEnds := map[string][]time.Time{} // Категория -> время окончания последнего отрезка на дорожке
+	laneBase := map[string]int{SpanCategorySystem: 1, SpanCategoryRPC: 100}
+	lanesUsed := map[int]string{0: "tick"}
+
+	for _, trace := range traces {
+		events = append(events, chromeEvent{
+			Name:     "tick",
+			Category: SpanCategoryTick,
+			Phase:    "X",
+			TS:       micros(trace.Start),
+			Duration: float64(trace.Duration.Nanoseconds()) / 1e3,
+			PID:      1,
+			Args:     map[string]interface{}{"tick": trace.Tick},
+		})
+
+		spans := append([]TraceSpan(nil), trace.Spans...)
+		sort.SliceStable(spans, func(i, j int) bool { return spans[i].Start.Before(spans[j].Start) })
+
+		for _, span := range spans {
+			ends := laneEnds[span.Category]
+			lane := 0
+			for lane < len(ends) && ends[lane].After(span.Start) {
+				lane++
+			}
+			if lane == len(ends) {
+				ends = append(ends, time.Time{})
+			}
+			ends[lane] = span.Start.Add(span.Duration)
+			laneEnds[span.Category] = ends
+
+			tid := laneBase[span.Category] + lane
+			lanesUsed[tid] = span.Category
+			events = append(events, chromeEvent{
+				Name:     span.Name,
+				Category: span.Category,
+				Phase:    "X",
+				TS:       micros(span.Start),
+				Duration: float64(span.Duration.Nanoseconds()) / 1e3,
+				PID:      1,
+				TID:      tid,
+				Args:     map[string]interface{}{"tick": trace.Tick},
+			})
+		}
+	}
+
+	// Названия дорожек идут первыми
+	tids := make([]int, 0, len(lanesUsed))
+	for tid := range lanesUsed {
+		tids = append(tids, tid)
+	}
+	sort.Ints(tids)
+
+	meta := make([]chromeEvent, 0, len(tids))
+	for _, tid := range tids {
+		meta = append(meta, chromeEvent{
+			Name:  "thread_name",
+			Phase: "M",
+			PID:   1,
+			TID:   tid,
+			Args:  map[string]interface{}{"name": lanesUsed[tid]},
+		})
+	}
+	events = append(meta, events...)
+
+	return json.Marshal(map[string]interface{}{
+		"traceEvents":     events,
+		"displayTimeUnit": "ms",
+	})
+}
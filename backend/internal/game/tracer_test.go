@@ -0,0 +1,137 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"x-cells/backend/internal/transport"
+)
+
+func TestTickTracer_RingBufferAndRPC(t *testing.T) {
+	tracer := NewTickTracer(3)
+	start := time.Unix(1000, 0)
+
+	// Вызов вне тика не записывается
+	tracer.RecordRPC(0, "CreateObject", start, time.Millisecond)
+
+	for tick := uint64(1); tick <= 5; tick++ {
+		tracer.beginTick(tick, start)
+		tracer.RecordRPC(tick, "GetObjectState", start, time.Millisecond)
+		tracer.RecordRPC(tick-1, "ApplyImpulse", start, time.Millisecond) // Вызов прошлого тика
+		tracer.endTick(2*time.Millisecond, tick == 2)
+	}
+
+	recent := tracer.Recent()
+	if len(recent) != 3 || recent[0].Tick != 3 || recent[2].Tick != 5 {
+		t.Fatalf("Ожидали тики 3..5 в буфере, получили %d трасс", len(recent))
+	}
+	if len(recent[0].Spans) != 1 || recent[0].Spans[0].Category != SpanCategoryRPC {
+		t.Errorf("Ожидали один вызов физики в трассе, получили %+v", recent[0].Spans)
+	}
+
+	// Медленный тик не вытесняется обычными
+	if trace, ok := tracer.SlowTrace(2); !ok || trace.Tick != 2 {
+		t.Error("Трасса медленного тика 2 должна сохраниться")
+	}
+	if infos := tracer.SlowTraces(); len(infos) != 1 || infos[0].Spans != 1 {
+		t.Errorf("Неверный список медленных тиков: %+v", infos)
+	}
+}
+
+func TestGameTicker_CapturesSlowTick(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(200, nil, logger) // Тик 5ms, критический порог 10ms
+	gameTicker.SetSystemWorkers(1)
+	gameTicker.RegisterSystem(&countingSystem{})
+	gameTicker.RegisterSystem(&declaredSystem{name: "slow", priority: 2, update: func() {
+		time.Sleep(15 * time.Millisecond)
+	}})
+
+	gameTicker.Pause()
+	gameTicker.Step(1)
+
+	trace, ok := gameTicker.Tracer().LatestSlowTrace()
+	if !ok {
+		t.Fatal("Медленный тик должен быть сохранен")
+	}
+	if len(trace.Spans) != 2 || trace.Spans[1].Name != "slow" || trace.Spans[1].Duration < 15*time.Millisecond {
+		t.Errorf("Ожидали отрезки обеих систем, получили %+v", trace.Spans)
+	}
+}
+
+func TestChromeTrace_Lanes(t *testing.T) {
+	start := time.Unix(1000, 0)
+	trace := &TickTrace{
+		Tick:     7,
+		Start:    start,
+		Duration: 10 * time.Millisecond,
+		Spans: []TraceSpan{
+			{Name: "a", Category: SpanCategorySystem, Start: start, Duration: 4 * time.Millisecond},
+			{Name: "b", Category: SpanCategorySystem, Start: start.Add(time.Millisecond), Duration: 2 * time.Millisecond},
+			{Name: "c", Category: SpanCategorySystem, Start: start.Add(5 * time.Millisecond), Duration: time.Millisecond},
+			{Name: "ApplyImpulse", Category: SpanCategoryRPC, Start: start.Add(2 * time.Millisecond), Duration: time.Millisecond},
+		},
+	}
+
+	data, err := ChromeTrace([]*TickTrace{trace})
+	if err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+
+	var parsed struct {
+		TraceEvents []chromeEvent `json:"traceEvents"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("Невалидный JSON: %v", err)
+	}
+
+	tids := map[string]int{}
+	for _, event := range parsed.TraceEvents {
+		if event.Phase == "X" {
+			tids[event.Name] = event.TID
+		}
+	}
+	// Перекрывающиеся системы - на разных дорожках, следующая занимает освободившуюся
+	expected := map[string]int{"tick": 0, "a": 1, "b": 2, "c": 1, "ApplyImpulse": 100}
+	for name, tid := range expected {
+		if tids[name] != tid {
+			t.Errorf("%s: ожидали дорожку %d, получили %d", name, tid, tids[name])
+		}
+	}
+	if parsed.TraceEvents[0].Phase != "M" {
+		t.Error("Названия дорожек должны идти первыми")
+	}
+}
+
+// contextSystem запоминает тик, которым помечен контекст ее выполнения
+type contextSystem struct {
+	SystemContext
+	ticks []uint64
+}
+
+func (s *contextSystem) Update(deltaTime time.Duration) error {
+	if tick, ok := transport.TickFromContext(s.Context()); ok {
+		s.ticks = append(s.ticks, tick)
+	}
+	return nil
+}
+
+func (s *contextSystem) GetName() string  { return "context" }
+func (s *contextSystem) GetPriority() int { return 1 }
+
+func TestGameTicker_PassesTickContext(t *testing.T) {
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	system := &contextSystem{}
+	gameTicker.RegisterSystem(system)
+
+	gameTicker.Pause()
+	gameTicker.Step(2)
+
+	if len(system.ticks) != 2 || system.ticks[0] != 1 || system.ticks[1] != 2 {
+		t.Errorf("Ожидали контексты тиков 1 и 2, получили %v", system.ticks)
+	}
+}
@@ -0,0 +1,398 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WatchdogConfig настройки сторожа систем. Сторож следит, чтобы одна система
+// не могла остановить или постоянно замедлять весь игровой цикл.
+type WatchdogConfig struct {
+	Budget      time.Duration // Бюджет времени системы на одно выполнение
+	HangTimeout time.Duration // Сколько тик ждет систему, прежде чем бросить ее
+
+	Window      time.Duration // Окно игрового времени для подсчета нарушений
+	MaxOverruns int           // Превышений бюджета в окне до карантина
+	MaxPanics   int           // Паник и ошибок в окне до карантина
+
+	BaseQuarantine time.Duration // Первый карантин; каждый следующий вдвое дольше
+	MaxQuarantine  time.Duration // Предел удвоения карантина
+}
+
+// DefaultWatchdogConfig возвращает настройки сторожа по умолчанию
+func DefaultWatchdogConfig() WatchdogConfig {
+	return WatchdogConfig{
+		Budget:         25 * time.Millisecond,
+		HangTimeout:    time.Second,
+		Window:         30 * time.Second,
+		MaxOverruns:    10,
+		MaxPanics:      3,
+		BaseQuarantine: 5 * time.Second,
+		MaxQuarantine:  5 * time.Minute,
+	}
+}
+
+// withDefaults подставляет значения по умолчанию вместо незаданных
+func (c WatchdogConfig) withDefaults() WatchdogConfig {
+	defaults := DefaultWatchdogConfig()
+	if c.Budget <= 0 {
+		c.Budget = defaults.Budget
+	}
+	if c.HangTimeout <= 0 {
+		c.HangTimeout = defaults.HangTimeout
+	}
+	if c.Window <= 0 {
+		c.Window = defaults.Window
+	}
+	if c.MaxOverruns <= 0 {
+		c.MaxOverruns = defaults.MaxOverruns
+	}
+	if c.MaxPanics <= 0 {
+		c.MaxPanics = defaults.MaxPanics
+	}
+	if c.BaseQuarantine <= 0 {
+		c.BaseQuarantine = defaults.BaseQuarantine
+	}
+	if c.MaxQuarantine < c.BaseQuarantine {
+		c.MaxQuarantine = max(defaults.MaxQuarantine, c.BaseQuarantine)
+	}
+	return c
+}
+
+// AlertKind вид оповещения сторожа
+type AlertKind string
+
+const (
+	AlertHang       AlertKind = "hang"       // Система не уложилась в HangTimeout
+	AlertQuarantine AlertKind = "quarantine" // Система отправлена в карантин
+	AlertRelease    AlertKind = "release"    // Система вышла из карантина
+)
+
+// Alert оповещение сторожа о системе
+type Alert struct {
+	Time    time.Time  `json:"time"`
+	System  string     `json:"system"`
+	Kind    AlertKind  `json:"kind"`
+	Message string     `json:"message"`
+	Until   *time.Time `json:"until,omitempty"` // Конец карантина
+}
+
+// maxRecentAlerts сколько последних оповещений хранит монитор
+const maxRecentAlerts = 100
+
+// systemWatch состояние системы для сторожа
+type systemWatch struct {
+	panics   []time.Time // Паники и ошибки в текущем окне
+	overruns []time.Time // Превышения бюджета в текущем окне
+
+	quarantinedUntil time.Time
+	level            int       // Карантинов подряд, определяет длительность следующего
+	releasedAt       time.Time // Выход из карантина; уровень сбрасывается после чистого окна
+	hung             bool      // Update еще выполняется после HangTimeout
+	blockedBy        string    // Зависшая система, из-за которой эта пропускается
+}
+
+// quarantined сообщает, находится ли система в карантине
+func (w *systemWatch) quarantined() bool {
+	return !w.quarantinedUntil.IsZero()
+}
+
+// systemRun результат выполнения системы под присмотром сторожа
+type systemRun struct {
+	duration time.Duration
+	failed   bool
+}
+
+// EnableWatchdog включает сторож систем. Вызывать до Start.
+//
+// Под сторожем Update каждой системы выполняется в отдельной горутине. Если система
+// не вернулась за HangTimeout, ее контекст отменяется, тик продолжается без нее,
+// а система отправляется в карантин и не запускается снова, пока зависший вызов
+// не завершится. До этого же момента пропускаются системы, которые конфликтуют
+// с ней по ресурсам. Системы, которые часто паникуют или превышают бюджет, тоже
+// уходят в карантин; каждый следующий карантин вдвое дольше предыдущего.
+func (gt *GameTicker) EnableWatchdog(cfg WatchdogConfig) {
+	cfg = cfg.withDefaults()
+	gt.watchdog = &cfg
+	gt.logger.Printf("[Watchdog] Бюджет системы %v, зависание через %v, карантин от %v до %v",
+		cfg.Budget, cfg.HangTimeout, cfg.BaseQuarantine, cfg.MaxQuarantine)
+}
+
+// admitSystem решает, может ли система выполняться в этом тике, и выпускает
+// ее из карантина по истечении срока. Вызывается под systemsMutex.
+func (gt *GameTicker) admitSystem(name string, state *systemState, now time.Time) bool {
+	watch := &state.watch
+	if watch.hung {
+		return false
+	}
+	if !watch.quarantined() {
+		return true
+	}
+	if now.Before(watch.quarantinedUntil) {
+		return false
+	}
+
+	gt.release(name, state, now, "срок карантина истек")
+	return true
+}
+
+// release выпускает систему из карантина. Вызывается под systemsMutex.
+func (gt *GameTicker) release(name string, state *systemState, now time.Time, reason string) {
+	state.watch.quarantinedUntil = time.Time{}
+	state.watch.releasedAt = now
+	state.watch.panics = nil
+	state.watch.overruns = nil
+	// Время карантина не должно попасть в deltaTime первого выполнения
+	state.reset()
+
+	gt.raiseAlert(Alert{Time: now, System: name, Kind: AlertRelease, Message: reason})
+}
+
+// quarantine отправляет систему в карантин. Вызывается под systemsMutex.
+func (gt *GameTicker) quarantine(name string, state *systemState, now time.Time, reason string) {
+	cfg := gt.watchdog
+	watch := &state.watch
+
+	duration := cfg.BaseQuarantine
+	for i := 0; i < watch.level && duration < cfg.MaxQuarantine; i++ {
+		duration *= 2
+	}
+	duration = min(duration, cfg.MaxQuarantine)
+
+	watch.level++
+	watch.quarantinedUntil = now.Add(duration)
+	watch.releasedAt = time.Time{}
+	watch.panics = nil
+	watch.overruns = nil
+
+	until := watch.quarantinedUntil
+	gt.raiseAlert(Alert{
+		Time:    now,
+		System:  name,
+		Kind:    AlertQuarantine,
+		Message: fmt.Sprintf("%s, карантин %v (уровень %d)", reason, duration, watch.level),
+		Until:   &until,
+	})
+}
+
+// executeWatched выполняет систему под присмотром сторожа со своим отменяемым
+// контекстом. Зависшая система бросается после HangTimeout (hung = true): ее контекст
+// отменяется, чтобы прервать вызовы физики, а горутина продолжает работать сама
+// по себе, поэтому конфликтующие с ней по access системы ждут ее возвращения.
+func (gt *GameTicker) executeWatched(ctx context.Context, system TickSystem, access *SystemAccess,
+	deltaTime time.Duration) (duration time.Duration, hung bool) {
+	name := system.GetName()
+	start := time.Now()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan systemRun, 1)
+	go func() {
+		defer cancel()
+		duration, failed := gt.executeSystem(ctx, system, deltaTime)
+		done <- systemRun{duration: duration, failed: failed}
+	}()
+
+	timer := time.NewTimer(gt.watchdog.HangTimeout)
+	defer timer.Stop()
+
+	select {
+	case run := <-done:
+		gt.watchRun(name, run)
+		return run.duration, false
+	case <-timer.C:
+		gt.watchHang(name, access, cancel)
+		go func() {
+			run := <-done
+			gt.watchReturn(name, run)
+		}()
+		return time.Since(start), true
+	}
+}
+
+// conflictingHung возвращает зависшую систему, с которой конфликтует система с access
+func (gt *GameTicker) conflictingHung(access *SystemAccess) (string, bool) {
+	gt.systemsMutex.RLock()
+	defer gt.systemsMutex.RUnlock()
+
+	for name, hungAccess := range gt.hungSystems {
+		if conflicts(hungAccess, access) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// skipConflicting пропускает выполнение системы, конфликтующей с зависшей.
+// deltaTime пропущенного выполнения переходит в следующее. В лог попадает только
+// первый пропуск из-за каждой зависшей системы.
+func (gt *GameTicker) skipConflicting(name, hung string, deltaTime time.Duration) {
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	state, ok := gt.schedules[name]
+	if !ok {
+		return
+	}
+	state.postpone(deltaTime)
+	if state.watch.blockedBy != hung {
+		state.watch.blockedBy = hung
+		gt.logger.Printf("[Watchdog] Система %s пропускается: конфликтует с зависшей системой %s", name, hung)
+	}
+}
+
+// watchRun учитывает выполнение системы и отправляет ее в карантин, если
+// паник или превышений бюджета в окне стало слишком много
+func (gt *GameTicker) watchRun(name string, run systemRun) {
+	cfg := gt.watchdog
+
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	state, ok := gt.schedules[name]
+	if !ok {
+		return
+	}
+	watch := &state.watch
+	now := gt.clock.Now()
+
+	if run.failed {
+		watch.panics = append(trimWindow(watch.panics, now, cfg.Window), now)
+		if len(watch.panics) >= cfg.MaxPanics {
+			gt.quarantine(name, state, now, fmt.Sprintf("%d ошибок за %v", len(watch.panics), cfg.Window))
+			return
+		}
+	}
+
+	if run.duration > cfg.Budget {
+		watch.overruns = append(trimWindow(watch.overruns, now, cfg.Window), now)
+		if len(watch.overruns) >= cfg.MaxOverruns {
+			gt.quarantine(name, state, now, fmt.Sprintf("%d превышений бюджета %v за %v",
+				len(watch.overruns), cfg.Budget, cfg.Window))
+			return
+		}
+	}
+
+	// Система отработала окно после карантина без нарушений - прощаем прошлые карантины
+	if !watch.releasedAt.IsZero() && now.Sub(watch.releasedAt) >= cfg.Window &&
+		len(trimWindow(watch.panics, now, cfg.Window)) == 0 && len(trimWindow(watch.overruns, now, cfg.Window)) == 0 {
+		watch.level = 0
+		watch.releasedAt = time.Time{}
+	}
+}
+
+// watchHang отменяет контекст зависшей системы, запоминает ее ресурсы до
+// возвращения и отправляет ее в карантин
+func (gt *GameTicker) watchHang(name string, access *SystemAccess, cancel context.CancelFunc) {
+	cancel()
+
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	gt.hungSystems[name] = access
+	state, ok := gt.schedules[name]
+	if !ok {
+		return
+	}
+	now := gt.clock.Now()
+	state.watch.hung = true
+
+	gt.raiseAlert(Alert{
+		Time:    now,
+		System:  name,
+		Kind:    AlertHang,
+		Message: fmt.Sprintf("система не вернулась за %v, тик продолжен без нее", gt.watchdog.HangTimeout),
+	})
+	gt.quarantine(name, state, now, "зависание")
+}
+
+// watchReturn отмечает, что зависшая система наконец вернулась. Карантин
+// продолжается до своего срока.
+func (gt *GameTicker) watchReturn(name string, run systemRun) {
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	delete(gt.hungSystems, name)
+	if state, ok := gt.schedules[name]; ok {
+		state.watch.hung = false
+	}
+	for _, state := range gt.schedules {
+		if state.watch.blockedBy == name {
+			state.watch.blockedBy = ""
+		}
+	}
+	gt.logger.Printf("[Watchdog] Зависшая система %s вернулась через %v", name, run.duration)
+}
+
+// trimWindow отбрасывает отметки старше окна
+func trimWindow(times []time.Time, now time.Time, window time.Duration) []time.Time {
+	i := 0
+	for i < len(times) && now.Sub(times[i]) >= window {
+		i++
+	}
+	return times[i:]
+}
+
+// ReleaseSystem досрочно выпускает систему из карантина. Зависшая система
+// остается недоступной, пока ее вызов не завершится.
+func (gt *GameTicker) ReleaseSystem(name string) error {
+	gt.systemsMutex.Lock()
+	defer gt.systemsMutex.Unlock()
+
+	state, ok := gt.schedules[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrSystemNotFound, name)
+	}
+	if !state.watch.quarantined() {
+		return fmt.Errorf("система %s не в карантине", name)
+	}
+
+	gt.release(name, state, gt.clock.Now(), "выпущена вручную")
+	return nil
+}
+
+// Alerts возвращает последние оповещения сторожа, от старых к новым
+func (gt *GameTicker) Alerts() []Alert {
+	return gt.perfMonitor.recentAlerts()
+}
+
+// raiseAlert сохраняет оповещение в мониторе и пишет его в лог
+func (gt *GameTicker) raiseAlert(alert Alert) {
+	gt.perfMonitor.recordAlert(alert)
+	gt.logger.Printf("[Watchdog] КРИТИЧЕСКОЕ ОПОВЕЩЕНИЕ %s: система %s: %s", alert.Kind, alert.System, alert.Message)
+}
+
+// recordAlert добавляет оповещение в кольцевой буфер и счетчики
+func (pm *PerformanceMonitor) recordAlert(alert Alert) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	if pm.alertCounts == nil {
+		pm.alertCounts = make(map[AlertKind]uint64)
+	}
+	pm.alertCounts[alert.Kind]++
+
+	pm.alerts = append(pm.alerts, alert)
+	if len(pm.alerts) > maxRecentAlerts {
+		pm.alerts = append(pm.alerts[:0:0], pm.alerts[len(pm.alerts)-maxRecentAlerts:]...)
+	}
+}
+
+// recentAlerts возвращает копию последних оповещений
+func (pm *PerformanceMonitor) recentAlerts() []Alert {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+	return append([]Alert(nil), pm.alerts...)
+}
+
+// alertTotals возвращает количество оповещений каждого вида
+func (pm *PerformanceMonitor) alertTotals() map[AlertKind]uint64 {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	totals := map[AlertKind]uint64{AlertHang: 0, AlertQuarantine: 0, AlertRelease: 0}
+	for kind, count := range pm.alertCounts {
+		totals[kind] = count
+	}
+	return totals
+}
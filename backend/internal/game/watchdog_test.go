@@ -0,0 +1,278 @@
+package game
+
+import (
+	"log"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// faultySystem тестовая система, которая паникует, тормозит или зависает по требованию
+type faultySystem struct {
+	name  string
+	calls atomic.Int32
+	panic atomic.Bool
+	sleep time.Duration
+	block chan struct{} // Если не nil, Update ждет закрытия канала
+}
+
+func (s *faultySystem) Update(deltaTime time.Duration) error {
+	s.calls.Add(1)
+	if s.block != nil {
+		<-s.block
+	}
+	if s.sleep > 0 {
+		time.Sleep(s.sleep)
+	}
+	if s.panic.Load() {
+		panic("сбой системы")
+	}
+	return nil
+}
+
+func (s *faultySystem) GetName() string  { return s.name }
+func (s *faultySystem) GetPriority() int { return 1 }
+
+// declaredFaultySystem faultySystem с объявленным доступом к ресурсам
+type declaredFaultySystem struct {
+	*faultySystem
+	access SystemAccess
+}
+
+func (s *declaredFaultySystem) Access() SystemAccess { return s.access }
+
+func newWatchedTicker(t *testing.T, cfg WatchdogConfig) (*GameTicker, *ManualClock) {
+	t.Helper()
+	logger := log.New(os.Stdout, "[TEST] ", log.LstdFlags)
+	gameTicker := NewGameTicker(20, nil, logger)
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	gameTicker.SetClock(clock)
+	gameTicker.SetSystemWorkers(1)
+	gameTicker.EnableWatchdog(cfg)
+	gameTicker.Pause()
+	return gameTicker, clock
+}
+
+func systemInfo(gameTicker *GameTicker, name string) SystemInfo {
+	for _, info := range gameTicker.ListSystems() {
+		if info.Name == name {
+			return info
+		}
+	}
+	return SystemInfo{}
+}
+
+func TestWatchdog_QuarantinesPanickingSystemWithBackoff(t *testing.T) {
+	gameTicker, clock := newWatchedTicker(t, WatchdogConfig{
+		MaxPanics:      3,
+		Window:         time.Minute,
+		BaseQuarantine: time.Second,
+		MaxQuarantine:  3 * time.Second,
+	})
+
+	faulty := &faultySystem{name: "faulty"}
+	faulty.panic.Store(true)
+	healthy := &faultySystem{name: "healthy"}
+	gameTicker.RegisterSystem(faulty)
+	gameTicker.RegisterSystem(healthy)
+
+	gameTicker.Step(10)
+	if calls := faulty.calls.Load(); calls != 3 {
+		t.Errorf("Ожидали карантин после 3 паник, система вызвана %d раз", calls)
+	}
+	if calls := healthy.calls.Load(); calls != 10 {
+		t.Errorf("Исправная система должна выполняться каждый тик, вызвана %d раз", calls)
+	}
+	info := systemInfo(gameTicker, "faulty")
+	if info.QuarantinedUntil == nil || !info.QuarantinedUntil.Equal(clock.Now().Add(time.Second)) {
+		t.Fatalf("Ожидали карантин на 1s, получили %v", info.QuarantinedUntil)
+	}
+
+	// После карантина система снова выполняется, повторный карантин вдвое дольше
+	clock.Advance(time.Second)
+	gameTicker.Step(10)
+	if calls := faulty.calls.Load(); calls != 6 {
+		t.Errorf("После выхода из карантина ожидали еще 3 вызова, всего %d", calls)
+	}
+	info = systemInfo(gameTicker, "faulty")
+	if info.QuarantinedUntil == nil || !info.QuarantinedUntil.Equal(clock.Now().Add(2*time.Second)) {
+		t.Fatalf("Ожидали повторный карантин на 2s, получили %v", info.QuarantinedUntil)
+	}
+
+	// Удвоение ограничено MaxQuarantine
+	clock.Advance(2 * time.Second)
+	gameTicker.Step(3)
+	info = systemInfo(gameTicker, "faulty")
+	if info.QuarantinedUntil == nil || !info.QuarantinedUntil.Equal(clock.Now().Add(3*time.Second)) {
+		t.Fatalf("Ожидали карантин не дольше 3s, получили %v", info.QuarantinedUntil)
+	}
+
+	// Досрочный выпуск; исправившаяся система работает и через окно прощается
+	faulty.panic.Store(false)
+	if err := gameTicker.ReleaseSystem("faulty"); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	gameTicker.Step(1)
+	clock.Advance(time.Minute)
+	gameTicker.Step(1)
+	if info := systemInfo(gameTicker, "faulty"); info.QuarantinedUntil != nil || info.QuarantineLevel != 0 {
+		t.Errorf("Ожидали сброс карантина после чистого окна, получили %+v", info)
+	}
+	if err := gameTicker.ReleaseSystem("faulty"); err == nil {
+		t.Error("Ожидали ошибку при выпуске системы не в карантине")
+	}
+
+	kinds := map[AlertKind]int{}
+	for _, alert := range gameTicker.Alerts() {
+		kinds[alert.Kind]++
+	}
+	if kinds[AlertQuarantine] != 3 || kinds[AlertRelease] != 3 {
+		t.Errorf("Ожидали 3 карантина и 3 выпуска, получили %v", kinds)
+	}
+}
+
+func TestWatchdog_QuarantinesSlowSystem(t *testing.T) {
+	gameTicker, _ := newWatchedTicker(t, WatchdogConfig{
+		Budget:      time.Millisecond,
+		MaxOverruns: 2,
+		Window:      time.Minute,
+	})
+
+	slow := &faultySystem{name: "slow", sleep: 5 * time.Millisecond}
+	gameTicker.RegisterSystem(slow)
+
+	gameTicker.Step(5)
+	if calls := slow.calls.Load(); calls != 2 {
+		t.Errorf("Ожидали карантин после 2 превышений бюджета, система вызвана %d раз", calls)
+	}
+	if info := systemInfo(gameTicker, "slow"); info.QuarantinedUntil == nil {
+		t.Error("Медленная система не в карантине")
+	}
+}
+
+func TestWatchdog_AbandonsHungSystem(t *testing.T) {
+	gameTicker, clock := newWatchedTicker(t, WatchdogConfig{
+		HangTimeout:    20 * time.Millisecond,
+		BaseQuarantine: time.Second,
+	})
+
+	hung := &faultySystem{name: "hung", block: make(chan struct{})}
+	healthy := &faultySystem{name: "healthy"}
+	gameTicker.RegisterSystem(&declaredFaultySystem{hung, SystemAccess{Writes: []string{ResourcePhysics}}})
+	gameTicker.RegisterSystem(&declaredFaultySystem{healthy, SystemAccess{Writes: []string{ResourceFood}}})
+
+	start := time.Now()
+	gameTicker.Step(3)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Зависшая система задержала тики на %v", elapsed)
+	}
+	if calls := hung.calls.Load(); calls != 1 {
+		t.Errorf("Зависшая система не должна запускаться повторно, вызвана %d раз", calls)
+	}
+	if calls := healthy.calls.Load(); calls != 3 {
+		t.Errorf("Исправная система должна выполняться каждый тик, вызвана %d раз", calls)
+	}
+	if info := systemInfo(gameTicker, "hung"); !info.Hung || info.QuarantinedUntil == nil {
+		t.Fatalf("Ожидали зависшую систему в карантине, получили %+v", info)
+	}
+
+	// Пока вызов не вернулся, система не выходит из карантина
+	clock.Advance(time.Second)
+	gameTicker.Step(1)
+	if calls := hung.calls.Load(); calls != 1 {
+		t.Errorf("Зависшая система запущена до возврата, вызвана %d раз", calls)
+	}
+
+	close(hung.block)
+	deadline := time.Now().Add(time.Second)
+	for systemInfo(gameTicker, "hung").Hung && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	gameTicker.Step(1)
+	if calls := hung.calls.Load(); calls != 2 {
+		t.Errorf("После возврата и конца карантина ожидали повторный запуск, вызвана %d раз", calls)
+	}
+
+	if alerts := gameTicker.Alerts(); len(alerts) == 0 || alerts[0].Kind != AlertHang {
+		t.Errorf("Ожидали первым оповещение о зависании, получили %+v", alerts)
+	}
+}
+
+func TestWatchdog_SkipsSystemsConflictingWithHungSystem(t *testing.T) {
+	gameTicker, _ := newWatchedTicker(t, WatchdogConfig{
+		HangTimeout:    20 * time.Millisecond,
+		BaseQuarantine: time.Second,
+	})
+
+	hung := &faultySystem{name: "hung", block: make(chan struct{})}
+	defer close(hung.block)
+	reader := &faultySystem{name: "reader"}
+	food := &faultySystem{name: "food"}
+	gameTicker.RegisterSystem(&declaredFaultySystem{hung, SystemAccess{Writes: []string{ResourcePhysics}}})
+	gameTicker.RegisterSystem(&declaredFaultySystem{reader, SystemAccess{Reads: []string{ResourcePhysics}}})
+	gameTicker.RegisterSystem(&declaredFaultySystem{food, SystemAccess{Writes: []string{ResourceFood}}})
+
+	gameTicker.Step(1)
+	if calls := reader.calls.Load(); calls != 0 {
+		t.Errorf("Система, читающая ресурс зависшей, не должна выполняться в том же тике, вызвана %d раз", calls)
+	}
+	if calls := food.calls.Load(); calls != 1 {
+		t.Errorf("Независимая система должна выполниться, вызвана %d раз", calls)
+	}
+
+	// Зависший вызов все еще выполняется: конфликтующая система ждет и в следующих тиках
+	gameTicker.Step(2)
+	if calls := reader.calls.Load(); calls != 0 {
+		t.Errorf("Пока зависшая система не вернулась, конфликтующая не должна выполняться, вызвана %d раз", calls)
+	}
+	if calls := food.calls.Load(); calls != 3 {
+		t.Errorf("Независимая система должна выполняться каждый тик, вызвана %d раз", calls)
+	}
+
+	hung.block <- struct{}{}
+	deadline := time.Now().Add(time.Second)
+	for systemInfo(gameTicker, "hung").Hung && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	gameTicker.Step(1)
+	if calls := reader.calls.Load(); calls != 1 {
+		t.Errorf("После возврата зависшей системы конфликтующая должна выполниться, вызвана %d раз", calls)
+	}
+}
+
+// cancellableSystem ждет отмены своего контекста, как зависший вызов физики
+type cancellableSystem struct {
+	SystemContext
+	cancelled chan struct{}
+}
+
+func (s *cancellableSystem) Update(deltaTime time.Duration) error {
+	<-s.Context().Done()
+	close(s.cancelled)
+	return s.Context().Err()
+}
+
+func (s *cancellableSystem) GetName() string  { return "cancellable" }
+func (s *cancellableSystem) GetPriority() int { return 1 }
+
+func TestWatchdog_CancelsContextOfHungSystem(t *testing.T) {
+	gameTicker, _ := newWatchedTicker(t, WatchdogConfig{HangTimeout: 20 * time.Millisecond})
+
+	system := &cancellableSystem{cancelled: make(chan struct{})}
+	gameTicker.RegisterSystem(system)
+	gameTicker.Step(1)
+
+	select {
+	case <-system.cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("Контекст зависшей системы должен быть отменен")
+	}
+	deadline := time.Now().Add(time.Second)
+	for systemInfo(gameTicker, "cancellable").Hung && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if systemInfo(gameTicker, "cancellable").Hung {
+		t.Error("После отмены контекста система должна вернуться")
+	}
+}
@@ -0,0 +1,262 @@
+// Package metrics содержит гистограммы и запись метрик в текстовом формате
+// Prometheus (exposition format 0.0.4) без клиентской библиотеки.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ContentType заголовок ответа эндпоинта /metrics
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Стандартные границы гистограмм в секундах
+var (
+	TickBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25}
+	RPCBuckets  = []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.5}
+)
+
+// Histogram потокобезопасная гистограмма с фиксированными границами
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []float64 // Верхние границы по возрастанию, без +Inf
+	counts  []uint64  // Наблюдения в каждом интервале (не накопительно)
+	sum     float64
+	count   uint64
+}
+
+// NewHistogram создает гистограмму с заданными верхними границами
+func NewHistogram(buckets []float64) *Histogram {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+	return &Histogram{
+		buckets: sorted,
+		counts:  make([]uint64, len(sorted)+1),
+	}
+}
+
+// Observe добавляет наблюдение
+func (h *Histogram) Observe(value float64) {
+	i := sort.SearchFloat64s(h.buckets, value)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[i]++
+	h.sum += value
+	h.count++
+}
+
+// ObserveDuration добавляет длительность в секундах
+func (h *Histogram) ObserveDuration(d time.Duration) {
+	h.Observe(d.Seconds())
+}
+
+// HistogramSnapshot накопительные значения гистограммы для записи
+type HistogramSnapshot struct {
+	Buckets    []float64
+	Cumulative []uint64 // Наблюдения <= границы, последний элемент - для +Inf
+	Sum        float64
+	Count      uint64
+}
+
+// Snapshot возвращает согласованную копию гистограммы
+func (h *Histogram) Snapshot() HistogramSnapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	snapshot := HistogramSnapshot{
+		Buckets:    h.buckets,
+		Cumulative: make([]uint64, len(h.counts)),
+		Sum:        h.sum,
+		Count:      h.count,
+	}
+	var total uint64
+	for i, c := range h.counts {
+		total += c
+		snapshot.Cumulative[i] = total
+	}
+	return snapshot
+}
+
+// HistogramVec набор гистограмм, различающихся значением одной метки
+type HistogramVec struct {
+	mu         sync.RWMutex
+	buckets    []float64
+	histograms map[string]*Histogram
+}
+
+// NewHistogramVec создает набор гистограмм с общими границами
+func NewHistogramVec(buckets []float64) *HistogramVec {
+	return &HistogramVec{
+		buckets:    buckets,
+		histograms: make(map[string]*Histogram),
+	}
+}
+
+// With возвращает гистограмму для значения метки, создавая ее при первом обращении
+func (v *HistogramVec) With(label string) *Histogram {
+	v.mu.RLock()
+	h, ok := v.histograms[label]
+	v.mu.RUnlock()
+	if ok {
+		return h
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if h, ok = v.histograms[label]; !ok {
+		h = NewHistogram(v.buckets)
+		v.histograms[label] = h
+	}
+	return h
+}
+
+// Delete удаляет гистограмму для значения метки
+func (v *HistogramVec) Delete(label string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.histograms, label)
+}
+
+// Each обходит гистограммы в порядке значений метки
+func (v *HistogramVec) Each(fn func(label string, h *Histogram)) {
+	v.mu.RLock()
+	labels := make([]string, 0, len(v.histograms))
+	for label := range v.histograms {
+		labels = append(labels, label)
+	}
+	v.mu.RUnlock()
+
+	sort.Strings(labels)
+	for _, label := range labels {
+		v.mu.RLock()
+		h, ok := v.histograms[label]
+		v.mu.RUnlock()
+		if ok {
+			fn(label, h)
+		}
+	}
+}
+
+// Label пара имя-значение метки
+type Label struct {
+	Name, Value string
+}
+
+// Exposition пишет метрики в текстовом формате Prometheus.
+// Первая ошибка записи сохраняется, последующие вызовы ничего не делают.
+type Exposition struct {
+	w   io.Writer
+	err error
+}
+
+// NewExposition создает запись метрик в w
+func NewExposition(w io.Writer) *Exposition {
+	return &Exposition{w: w}
+}
+
+// Err возвращает первую ошибку записи
+func (e *Exposition) Err() error {
+	return e.err
+}
+
+// Header пишет строки HELP и TYPE метрики (typ: counter, gauge или histogram)
+func (e *Exposition) Header(name, typ, help string) {
+	e.printf("# HELP %s %s\n# TYPE %s %s\n", name, escapeHelp(help), name, typ)
+}
+
+// Sample пишет одно значение метрики
+func (e *Exposition) Sample(name string, value float64, labels ...Label) {
+	e.printf("%s%s %s\n", name, formatLabels(labels), formatValue(value))
+}
+
+// Gauge пишет заголовок и одно значение метрики-датчика без меток
+func (e *Exposition) Gauge(name, help string, value float64) {
+	e.Header(name, "gauge", help)
+	e.Sample(name, value)
+}
+
+// Counter пишет заголовок и одно значение счетчика без меток
+func (e *Exposition) Counter(name, help string, value float64) {
+	e.Header(name, "counter", help)
+	e.Sample(name, value)
+}
+
+// Histogram пишет ряды _bucket, _sum и _count гистограммы. Заголовок пишется отдельно,
+// чтобы несколько гистограмм с разными метками шли под одним HELP/TYPE.
+func (e *Exposition) Histogram(name string, snapshot HistogramSnapshot, labels ...Label) {
+	for i, bound := range snapshot.Buckets {
+		e.Sample(name+"_bucket", float64(snapshot.Cumulative[i]), withLabel(labels, "le", formatValue(bound))...)
+	}
+	e.Sample(name+"_bucket", float64(snapshot.Count), withLabel(labels, "le", "+Inf")...)
+	e.Sample(name+"_sum", snapshot.Sum, labels...)
+	e.Sample(name+"_count", float64(snapshot.Count), labels...)
+}
+
+// HistogramVec пишет заголовок и все гистограммы набора с меткой labelName
+func (e *Exposition) HistogramVec(name, help, labelName string, vec *HistogramVec) {
+	e.Header(name, "histogram", help)
+	vec.Each(func(label string, h *Histogram) {
+		e.Histogram(name, h.Snapshot(), Label{labelName, label})
+	})
+}
+
+func (e *Exposition) printf(format string, args ...interface{}) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = fmt.Fprintf(e.w, format, args...)
+}
+
+func withLabel(labels []Label, name, value string) []Label {
+	result := make([]Label, 0, len(labels)+1)
+	result = append(result, labels...)
+	return append(result, Label{name, value})
+}
+
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, label := range labels {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(label.Name)
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(label.Value))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
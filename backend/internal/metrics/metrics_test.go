@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHistogram_Snapshot(t *testing.T) {
+	h := NewHistogram([]float64{0.1, 0.01, 1})
+	for _, v := range []float64{0.005, 0.01, 0.05, 0.5, 2} {
+		h.Observe(v)
+	}
+
+	snapshot := h.Snapshot()
+	// Границы сортируются, значение на границе попадает в ее интервал (le - "меньше или равно")
+	expected := []uint64{2, 3, 4, 5}
+	for i, want := range expected {
+		if snapshot.Cumulative[i] != want {
+			t.Errorf("Интервал %d: ожидали %d, получили %d", i, want, snapshot.Cumulative[i])
+		}
+	}
+	if snapshot.Count != 5 || snapshot.Sum < 2.564 || snapshot.Sum > 2.566 {
+		t.Errorf("Неверные count/sum: %d / %v", snapshot.Count, snapshot.Sum)
+	}
+}
+
+func TestExposition_Format(t *testing.T) {
+	var b strings.Builder
+	e := NewExposition(&b)
+
+	e.Gauge("xcells_players", "Активные игроки", 3)
+	e.Header("xcells_world_objects", "gauge", "Объекты мира")
+	e.Sample("xcells_world_objects", 1.5, Label{"kind", `a"b\c`})
+
+	vec := NewHistogramVec([]float64{0.5, 1})
+	vec.With("Step").Observe(0.7)
+	vec.With("Create").Observe(0.2)
+	e.HistogramVec("xcells_rpc_seconds", "Вызовы", "method", vec)
+
+	if err := e.Err(); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+
+	expected := `# HELP xcells_players Активные игроки
+# TYPE xcells_players gauge
+xcells_players 3
+# HELP xcells_world_objects Объекты мира
+# TYPE xcells_world_objects gauge
+xcells_world_objects{kind="a\"b\\c"} 1.5
+# HELP xcells_rpc_seconds Вызовы
+# TYPE xcells_rpc_seconds histogram
+xcells_rpc_seconds_bucket{method="Create",le="0.5"} 1
+xcells_rpc_seconds_bucket{method="Create",le="1"} 1
+xcells_rpc_seconds_bucket{method="Create",le="+Inf"} 1
+xcells_rpc_seconds_sum{method="Create"} 0.2
+xcells_rpc_seconds_count{method="Create"} 1
+xcells_rpc_seconds_bucket{method="Step",le="0.5"} 0
+xcells_rpc_seconds_bucket{method="Step",le="1"} 1
+xcells_rpc_seconds_bucket{method="Step",le="+Inf"} 1
+xcells_rpc_seconds_sum{method="Step"} 0.7
+xcells_rpc_seconds_count{method="Step"} 1
+`
+	if b.String() != expected {
+		t.Errorf("Неверный вывод:\n%s\nожидали:\n%s", b.String(), expected)
+	}
+}
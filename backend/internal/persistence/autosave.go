@@ -0,0 +1,239 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/world"
+)
+
+// FoodStore система еды, состояние которой сохраняется вместе с миром
+type FoodStore interface {
+	GetFoodItems() map[string]*game.SimpleFood
+	RestoreFood(items []*game.SimpleFood)
+}
+
+// Capture собирает снапшот текущего состояния мира.
+// Объекты игроков не сохраняются: они создаются заново при подключении,
+// а их показатели попадают в Players.
+func Capture(worldManager *world.Manager, gameTicker *game.GameTicker, food FoodStore) *Snapshot {
+	snapshot := &Snapshot{
+		Version: CurrentVersion,
+		SavedAt: time.Now(),
+		Players: make(map[string]game.PlayerStats),
+	}
+
+	if gameTicker != nil {
+		snapshot.Tick = gameTicker.GetTickCount()
+		snapshot.Players = gameTicker.GetPlayerStats()
+	}
+
+	if worldManager != nil {
+		for _, obj := range worldManager.GetAllWorldObjects() {
+			if obj.Kind == world.KindPlayer {
+				continue
+			}
+			// Высоты террейна копируются: снапшот сериализуется вне блокировки мира
+			snapshot.Objects = append(snapshot.Objects, worldManager.CopyTerrainObject(obj))
+		}
+		sort.Slice(snapshot.Objects, func(i, j int) bool {
+			return snapshot.Objects[i].ID < snapshot.Objects[j].ID
+		})
+	}
+
+	if food != nil {
+		for _, item := range food.GetFoodItems() {
+			snapshot.Food = append(snapshot.Food, item)
+		}
+		sort.Slice(snapshot.Food, func(i, j int) bool {
+			return snapshot.Food[i].ID < snapshot.Food[j].ID
+		})
+	}
+
+	return snapshot
+}
+
+// RestoreWorld создает объекты из снапшота в игровом мире и в физических движках
+func RestoreWorld(snapshot *Snapshot, factory *world.Factory) {
+	for _, obj := range snapshot.Objects {
+		if obj == nil || obj.Object == nil {
+			continue
+		}
+
+		var err error
+		switch obj.PhysicsType {
+		case world.PhysicsTypeAmmo:
+			err = factory.CreateObjectInAmmo(obj)
+		default:
+			err = factory.CreateObjectBullet(obj)
+		}
+		if err != nil {
+			log.Printf("[Persistence] Ошибка восстановления объекта %s: %v", obj.ID, err)
+		}
+	}
+
+	log.Printf("[Persistence] Восстановлено объектов мира: %d", len(snapshot.Objects))
+}
+
+// RestoreGame восстанавливает еду и показатели игроков из снапшота
+func RestoreGame(snapshot *Snapshot, gameTicker *game.GameTicker, food FoodStore) {
+	if food != nil {
+		food.RestoreFood(snapshot.Food)
+	}
+	if gameTicker != nil && len(snapshot.Players) > 0 {
+		gameTicker.RestorePlayerStats(snapshot.Players)
+	}
+}
+
+// AutosaveSystem периодически сохраняет мир на диск.
+// Снапшот сериализуется в игровом цикле, запись на диск идет в отдельной горутине.
+type AutosaveSystem struct {
+	game.SystemClock
+
+	name     string
+	priority int
+	logger   *log.Logger
+
+	store    *Store
+	interval time.Duration
+	lastSave time.Time
+
+	worldManager *world.Manager
+	gameTicker   *game.GameTicker
+	food         FoodStore
+
+	writeMu sync.Mutex // Не даем записям на диск перекрываться
+	writing bool
+	pending sync.WaitGroup // Фоновые записи на диск
+}
+
+// NewAutosaveSystem создает систему автосохранения
+func NewAutosaveSystem(store *Store, interval time.Duration, worldManager *world.Manager,
+	gameTicker *game.GameTicker, food FoodStore, logger *log.Logger) *AutosaveSystem {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &AutosaveSystem{
+		name:         "AutosaveSystem",
+		priority:     1000, // После всех игровых систем
+		logger:       logger,
+		store:        store,
+		interval:     interval,
+		worldManager: worldManager,
+		gameTicker:   gameTicker,
+		food:         food,
+	}
+}
+
+// Update сохраняет мир, если с прошлого сохранения прошло больше interval
+func (as *AutosaveSystem) Update(deltaTime time.Duration) error {
+	if as.interval <= 0 {
+		return nil
+	}
+
+	now := as.Now()
+	if as.lastSave.IsZero() {
+		// Отсчет интервала начинается с первого тика
+		as.lastSave = now
+		return nil
+	}
+	if now.Sub(as.lastSave) < as.interval {
+		return nil
+	}
+
+	as.writeMu.Lock()
+	if as.writing {
+		// Предыдущее сохранение еще пишется - попробуем на следующем тике
+		as.writeMu.Unlock()
+		return nil
+	}
+	as.writing = true
+	as.writeMu.Unlock()
+
+	as.lastSave = now
+
+	snapshot := Capture(as.worldManager, as.gameTicker, as.food)
+	data, err := Encode(snapshot)
+	if err != nil {
+		as.finishWrite()
+		return err
+	}
+
+	as.pending.Add(1)
+	go func() {
+		defer as.pending.Done()
+		defer as.finishWrite()
+
+		path, err := as.store.Write(snapshot.SavedAt, data)
+		if err != nil {
+			as.logger.Printf("[AutosaveSystem] Ошибка автосохранения: %v", err)
+			return
+		}
+		as.logger.Printf("[AutosaveSystem] Мир сохранен в %s (%d КБ)", path, len(data)/1024)
+	}()
+
+	return nil
+}
+
+// SaveNow синхронно сохраняет мир (например, перед остановкой сервера)
+func (as *AutosaveSystem) SaveNow() error {
+	as.writeMu.Lock()
+	defer as.writeMu.Unlock()
+
+	path, err := as.store.Save(Capture(as.worldManager, as.gameTicker, as.food))
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения мира: %w", err)
+	}
+
+	as.logger.Printf("[AutosaveSystem] Мир сохранен в %s", path)
+	return nil
+}
+
+// Init проверяет директорию сохранений, чтобы ошибка проявилась при запуске,
+// а не при первом автосохранении
+func (as *AutosaveSystem) Init(ctx context.Context) error {
+	return as.store.Check()
+}
+
+// Shutdown дожидается фоновой записи и сохраняет мир после последнего тика
+func (as *AutosaveSystem) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		as.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("фоновое сохранение не завершилось: %w", ctx.Err())
+	}
+	return as.SaveNow()
+}
+
+func (as *AutosaveSystem) finishWrite() {
+	as.writeMu.Lock()
+	as.writing = false
+	as.writeMu.Unlock()
+}
+
+// GetName возвращает имя системы
+func (as *AutosaveSystem) GetName() string {
+	return as.name
+}
+
+// GetPriority возвращает приоритет системы
+func (as *AutosaveSystem) GetPriority() int {
+	return as.priority
+}
+
+// Access объявляет ресурсы системы для планировщика
+func (as *AutosaveSystem) Access() game.SystemAccess {
+	return game.SystemAccess{Reads: []string{game.ResourceWorld, game.ResourcePlayers, game.ResourceFood}}
+}
@@ -0,0 +1,214 @@
+package persistence
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/world"
+)
+
+func createTestSnapshot(savedAt time.Time) *Snapshot {
+	terrain := world.NewTerrain("terrain_1", world.Vector3{X: 1, Y: 2, Z: 3},
+		[]float32{0, 1, 2, 3}, 2, 2, 3, 3, 3, 0, 3)
+	terrain.PhysicsType = world.PhysicsTypeBoth
+
+	return &Snapshot{
+		SavedAt: savedAt,
+		Tick:    42,
+		Objects: []*world.WorldObject{terrain},
+		Food: []*game.SimpleFood{
+			{ID: "food_7", X: 1, Y: 2, Z: 3, Radius: 5, Mass: 10, Color: "#90EE90"},
+		},
+		Players: map[string]game.PlayerStats{
+			"player_1": {Score: 5, Mass: 30, Radius: 3.5, Health: 100},
+		},
+	}
+}
+
+func TestStore_SaveAndLoadLatest(t *testing.T) {
+	store := NewStore(t.TempDir(), 0)
+
+	base := time.Unix(1700000000, 0)
+	if _, err := store.Save(createTestSnapshot(base)); err != nil {
+		t.Fatalf("Ошибка сохранения: %v", err)
+	}
+	latest := createTestSnapshot(base.Add(time.Second))
+	latest.Tick = 100
+	if _, err := store.Save(latest); err != nil {
+		t.Fatalf("Ошибка сохранения: %v", err)
+	}
+
+	snapshot, err := store.LoadLatest()
+	if err != nil {
+		t.Fatalf("Ошибка загрузки: %v", err)
+	}
+
+	if snapshot.Version != CurrentVersion || snapshot.Tick != 100 {
+		t.Errorf("Ожидали последнее сохранение версии %d, получили версию %d, тик %d",
+			CurrentVersion, snapshot.Version, snapshot.Tick)
+	}
+
+	if len(snapshot.Objects) != 1 || snapshot.Objects[0].Shape.Terrain == nil {
+		t.Fatalf("Террейн не восстановлен: %+v", snapshot.Objects)
+	}
+	terrain := snapshot.Objects[0].Shape.Terrain
+	if terrain.Width != 2 || terrain.HeightData[3] != 3 || terrain.Origin.Z != 3 {
+		t.Errorf("Неверные данные террейна: %+v", terrain)
+	}
+
+	if len(snapshot.Food) != 1 || snapshot.Food[0].ID != "food_7" {
+		t.Errorf("Еда не восстановлена: %+v", snapshot.Food)
+	}
+	if stats := snapshot.Players["player_1"]; stats.Mass != 30 || stats.Score != 5 {
+		t.Errorf("Показатели игрока не восстановлены: %+v", stats)
+	}
+}
+
+func TestStore_NoSave(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "missing"), 0)
+
+	if _, err := store.LoadLatest(); err != ErrNoSave {
+		t.Errorf("Ожидали ErrNoSave, получили %v", err)
+	}
+}
+
+func TestStore_PruneAndSkipCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir, 2)
+
+	base := time.Unix(1700000000, 0)
+	for i := 0; i < 4; i++ {
+		snapshot := createTestSnapshot(base.Add(time.Duration(i) * time.Second))
+		snapshot.Tick = uint64(i)
+		if _, err := store.Save(snapshot); err != nil {
+			t.Fatalf("Ошибка сохранения: %v", err)
+		}
+	}
+
+	paths, _ := store.list()
+	if len(paths) != 2 {
+		t.Fatalf("Ожидали 2 сохранения после очистки, получили %d", len(paths))
+	}
+
+	// Портим последнее сохранение - должно загрузиться предыдущее
+	if err := os.WriteFile(paths[1], []byte("{broken"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := store.LoadLatest()
+	if err != nil {
+		t.Fatalf("Ошибка загрузки: %v", err)
+	}
+	if snapshot.Tick != 2 {
+		t.Errorf("Ожидали сохранение с тиком 2, получили %d", snapshot.Tick)
+	}
+}
+
+func TestDecode_Migration(t *testing.T) {
+	// Сохранение версии 0: счет игроков хранился в поле "scores"
+	RegisterMigration(0, func(data map[string]json.RawMessage) error {
+		var scores map[string]int64
+		if err := json.Unmarshal(data["scores"], &scores); err != nil {
+			return err
+		}
+
+		players := make(map[string]game.PlayerStats, len(scores))
+		for id, score := range scores {
+			players[id] = game.PlayerStats{Score: score, Mass: 1, Radius: 1}
+		}
+		delete(data, "scores")
+
+		var err error
+		data["players"], err = json.Marshal(players)
+		return err
+	})
+	defer func() {
+		migrationsMu.Lock()
+		delete(migrations, 0)
+		migrationsMu.Unlock()
+	}()
+
+	snapshot, err := Decode([]byte(`{"version": 0, "tick": 7, "scores": {"player_1": 12}}`))
+	if err != nil {
+		t.Fatalf("Ошибка миграции: %v", err)
+	}
+
+	if snapshot.Version != CurrentVersion || snapshot.Tick != 7 {
+		t.Errorf("Неверный результат миграции: версия %d, тик %d", snapshot.Version, snapshot.Tick)
+	}
+	if snapshot.Players["player_1"].Score != 12 {
+		t.Errorf("Счет игрока не перенесен: %+v", snapshot.Players)
+	}
+
+	if _, err := Decode([]byte(`{"version": 99}`)); err == nil {
+		t.Error("Ожидали ошибку для сохранения из будущей версии")
+	}
+}
+
+func TestRestoreGame_ResumeToken(t *testing.T) {
+	gameTicker := game.NewGameTicker(20, nil, nil)
+	gameTicker.AddPlayerWithRadiusAndMass("player_1", game.Vector3{}, 2, 25)
+	gameTicker.SetResumeToken("player_1", "secret")
+	snapshot := Capture(nil, gameTicker, nil)
+	snapshot.Players["player_2"] = game.PlayerStats{Mass: 10, Radius: 1} // Сохранение без токена
+
+	restored := game.NewGameTicker(20, nil, nil)
+	RestoreGame(snapshot, restored, nil)
+
+	if id, ok := restored.RestoredPlayerByToken("secret"); !ok || id != "player_1" {
+		t.Errorf("Игрок должен вернуться по токену, получили %q, %v", id, ok)
+	}
+	// Публичный ID игрока не дает доступа к его показателям
+	for _, token := range []string{"player_1", "player_2", ""} {
+		if id, ok := restored.RestoredPlayerByToken(token); ok {
+			t.Errorf("Токен %q не должен возвращать игрока, получили %s", token, id)
+		}
+	}
+}
+
+func TestAutosave_SavesOnShutdown(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "saves")
+	store := NewStore(dir, 0)
+
+	gameTicker := game.NewGameTicker(20, nil, nil)
+	gameTicker.AddPlayerWithRadiusAndMass("player_1", game.Vector3{}, 2, 25)
+	gameTicker.RegisterSystem(NewAutosaveSystem(store, 0, nil, gameTicker, nil, nil))
+
+	// Init создает директорию сохранений еще до первого тика
+	if err := gameTicker.Start(); err != nil {
+		t.Fatalf("Ошибка запуска: %v", err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatalf("Директория сохранений не создана при запуске: %v", err)
+	}
+
+	gameTicker.Stop()
+
+	snapshot, err := store.LoadLatest()
+	if err != nil {
+		t.Fatalf("При остановке мир должен сохраниться: %v", err)
+	}
+	if stats := snapshot.Players["player_1"]; stats.Mass != 25 {
+		t.Errorf("Показатели игрока не сохранены: %+v", snapshot.Players)
+	}
+}
+
+func TestAutosave_InitFailsForUnwritableDir(t *testing.T) {
+	// Файл на месте директории сохранений
+	path := filepath.Join(t.TempDir(), "saves")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	gameTicker := game.NewGameTicker(20, nil, nil)
+	gameTicker.RegisterSystem(NewAutosaveSystem(NewStore(path, 0), 0, nil, gameTicker, nil, nil))
+
+	if err := gameTicker.Start(); err == nil {
+		gameTicker.Stop()
+		t.Fatal("Запуск должен завершиться ошибкой инициализации автосохранения")
+	}
+}
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"x-cells/backend/internal/game"
+	"x-cells/backend/internal/world"
+)
+
+// CurrentVersion текущая версия формата сохранения.
+// При изменении формата увеличьте версию и зарегистрируйте миграцию со старой.
+const CurrentVersion = 1
+
+// Snapshot состояние мира, записываемое на диск
+type Snapshot struct {
+	Version int                         `json:"version"`
+	SavedAt time.Time                   `json:"saved_at"`
+	Tick    uint64                      `json:"tick"`
+	Objects []*world.WorldObject        `json:"objects"` // Объекты мира без игроков (террейн, пропсы)
+	Food    []*game.SimpleFood          `json:"food"`
+	Players map[string]game.PlayerStats `json:"players"`
+}
+
+// Migration переводит сырые данные сохранения из версии fromVersion в fromVersion+1
+type Migration func(data map[string]json.RawMessage) error
+
+var (
+	migrations   = make(map[int]Migration)
+	migrationsMu sync.RWMutex
+)
+
+// RegisterMigration регистрирует миграцию сохранения из версии fromVersion в fromVersion+1
+func RegisterMigration(fromVersion int, migration Migration) {
+	migrationsMu.Lock()
+	defer migrationsMu.Unlock()
+
+	migrations[fromVersion] = migration
+}
+
+// Encode сериализует снапшот в формат сохранения
+func Encode(snapshot *Snapshot) ([]byte, error) {
+	snapshot.Version = CurrentVersion
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка сериализации сохранения: %w", err)
+	}
+	return data, nil
+}
+
+// Decode разбирает сохранение, при необходимости применяя миграции до текущей версии
+func Decode(data []byte) (*Snapshot, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("ошибка разбора сохранения: %w", err)
+	}
+
+	var version int
+	if v, ok := raw["version"]; ok {
+		if err := json.Unmarshal(v, &version); err != nil {
+			return nil, fmt.Errorf("неверная версия сохранения: %w", err)
+		}
+	}
+	if version > CurrentVersion {
+		return nil, fmt.Errorf("версия сохранения %d новее поддерживаемой %d", version, CurrentVersion)
+	}
+
+	if version < CurrentVersion {
+		if err := migrate(raw, version); err != nil {
+			return nil, err
+		}
+		data, _ = json.Marshal(raw)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("ошибка разбора сохранения: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// migrate последовательно применяет миграции от version до CurrentVersion
+func migrate(raw map[string]json.RawMessage, version int) error {
+	migrationsMu.RLock()
+	defer migrationsMu.RUnlock()
+
+	for ; version < CurrentVersion; version++ {
+		migration, ok := migrations[version]
+		if !ok {
+			return fmt.Errorf("нет миграции сохранения с версии %d", version)
+		}
+		if err := migration(raw); err != nil {
+			return fmt.Errorf("ошибка миграции сохранения с версии %d: %w", version, err)
+		}
+	}
+
+	raw["version"], _ = json.Marshal(CurrentVersion)
+	return nil
+}
@@ -0,0 +1,159 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNoSave возвращается, если в директории нет ни одного сохранения
+var ErrNoSave = errors.New("сохранения не найдены")
+
+const (
+	savePrefix = "world_"
+	saveSuffix = ".json"
+)
+
+// Store хранит сохранения мира в директории, удаляя самые старые
+type Store struct {
+	dir  string
+	keep int // Сколько последних сохранений хранить (0 - все)
+}
+
+// NewStore создает хранилище сохранений в указанной директории
+func NewStore(dir string, keep int) *Store {
+	return &Store{
+		dir:  dir,
+		keep: keep,
+	}
+}
+
+// Check проверяет, что в директорию сохранений можно писать, создавая ее при необходимости
+func (s *Store) Check() error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("не удалось создать директорию сохранений %s: %w", s.dir, err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "tmp_*"+saveSuffix)
+	if err != nil {
+		return fmt.Errorf("директория сохранений %s недоступна для записи: %w", s.dir, err)
+	}
+	tmp.Close()
+	return os.Remove(tmp.Name())
+}
+
+// Save сериализует снапшот и записывает его на диск
+func (s *Store) Save(snapshot *Snapshot) (string, error) {
+	data, err := Encode(snapshot)
+	if err != nil {
+		return "", err
+	}
+	return s.Write(snapshot.SavedAt, data)
+}
+
+// Write атомарно записывает сериализованное сохранение: сначала во временный файл,
+// затем переименовывает его, чтобы обрыв записи не испортил последнее сохранение
+func (s *Store) Write(savedAt time.Time, data []byte) (string, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", fmt.Errorf("не удалось создать директорию сохранений %s: %w", s.dir, err)
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%s%020d%s", savePrefix, savedAt.UnixNano(), saveSuffix))
+
+	tmp, err := os.CreateTemp(s.dir, "tmp_*"+saveSuffix)
+	if err != nil {
+		return "", fmt.Errorf("не удалось создать временный файл сохранения: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("ошибка записи сохранения: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("ошибка записи сохранения: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("ошибка записи сохранения: %w", err)
+	}
+
+	s.prune()
+	return path, nil
+}
+
+// LoadLatest загружает самое свежее читаемое сохранение.
+// Поврежденные сохранения пропускаются в пользу более старых.
+func (s *Store) LoadLatest() (*Snapshot, error) {
+	paths, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(paths) - 1; i >= 0; i-- {
+		data, err := os.ReadFile(paths[i])
+		if err != nil {
+			log.Printf("[Persistence] Не удалось прочитать сохранение %s: %v", paths[i], err)
+			continue
+		}
+
+		snapshot, err := Decode(data)
+		if err != nil {
+			log.Printf("[Persistence] Пропускаем поврежденное сохранение %s: %v", paths[i], err)
+			continue
+		}
+
+		log.Printf("[Persistence] Загружено сохранение %s", paths[i])
+		return snapshot, nil
+	}
+
+	return nil, ErrNoSave
+}
+
+// list возвращает пути к сохранениям от старых к новым
+func (s *Store) list() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать директорию сохранений %s: %w", s.dir, err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, savePrefix) || !strings.HasSuffix(name, saveSuffix) {
+			continue
+		}
+		paths = append(paths, filepath.Join(s.dir, name))
+	}
+
+	// Время в имени дополнено нулями, поэтому лексикографический порядок совпадает с хронологическим
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// prune удаляет сохранения сверх лимита keep
+func (s *Store) prune() {
+	if s.keep <= 0 {
+		return
+	}
+
+	paths, err := s.list()
+	if err != nil {
+		log.Printf("[Persistence] Ошибка очистки старых сохранений: %v", err)
+		return
+	}
+
+	for len(paths) > s.keep {
+		if err := os.Remove(paths[0]); err != nil {
+			log.Printf("[Persistence] Не удалось удалить старое сохранение %s: %v", paths[0], err)
+		}
+		paths = paths[1:]
+	}
+}
@@ -27,6 +27,10 @@ const (
 	Physics_UpdateObjectRadius_FullMethodName        = "/physics.Physics/UpdateObjectRadius"
 	Physics_UpdateObjectMassAndRadius_FullMethodName = "/physics.Physics/UpdateObjectMassAndRadius"
 	Physics_SetPhysicsConfig_FullMethodName          = "/physics.Physics/SetPhysicsConfig"
+	Physics_RemoveObject_FullMethodName              = "/physics.Physics/RemoveObject"
+	Physics_SetMaterials_FullMethodName              = "/physics.Physics/SetMaterials"
+	Physics_SetObjectTransform_FullMethodName        = "/physics.Physics/SetObjectTransform"
+	Physics_UpdateTerrainRegion_FullMethodName       = "/physics.Physics/UpdateTerrainRegion"
 )
 
 // PhysicsClient is the client API for Physics service.
@@ -43,6 +47,10 @@ type PhysicsClient interface {
 	UpdateObjectRadius(ctx context.Context, in *UpdateObjectRadiusRequest, opts ...grpc.CallOption) (*UpdateObjectRadiusResponse, error)
 	UpdateObjectMassAndRadius(ctx context.Context, in *UpdateObjectMassAndRadiusRequest, opts ...grpc.CallOption) (*UpdateObjectMassAndRadiusResponse, error)
 	SetPhysicsConfig(ctx context.Context, in *SetPhysicsConfigRequest, opts ...grpc.CallOption) (*SetPhysicsConfigResponse, error)
+	RemoveObject(ctx context.Context, in *RemoveObjectRequest, opts ...grpc.CallOption) (*RemoveObjectResponse, error)
+	SetMaterials(ctx context.Context, in *SetMaterialsRequest, opts ...grpc.CallOption) (*SetMaterialsResponse, error)
+	SetObjectTransform(ctx context.Context, in *SetObjectTransformRequest, opts ...grpc.CallOption) (*SetObjectTransformResponse, error)
+	UpdateTerrainRegion(ctx context.Context, in *UpdateTerrainRegionRequest, opts ...grpc.CallOption) (*UpdateTerrainRegionResponse, error)
 }
 
 type physicsClient struct {
@@ -133,6 +141,46 @@ func (c *physicsClient) SetPhysicsConfig(ctx context.Context, in *SetPhysicsConf
 	return out, nil
 }
 
+func (c *physicsClient) RemoveObject(ctx context.Context, in *RemoveObjectRequest, opts ...grpc.CallOption) (*RemoveObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveObjectResponse)
+	err := c.cc.Invoke(ctx, Physics_RemoveObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *physicsClient) SetMaterials(ctx context.Context, in *SetMaterialsRequest, opts ...grpc.CallOption) (*SetMaterialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaterialsResponse)
+	err := c.cc.Invoke(ctx, Physics_SetMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *physicsClient) SetObjectTransform(ctx context.Context, in *SetObjectTransformRequest, opts ...grpc.CallOption) (*SetObjectTransformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetObjectTransformResponse)
+	err := c.cc.Invoke(ctx, Physics_SetObjectTransform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *physicsClient) UpdateTerrainRegion(ctx context.Context, in *UpdateTerrainRegionRequest, opts ...grpc.CallOption) (*UpdateTerrainRegionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTerrainRegionResponse)
+	err := c.cc.Invoke(ctx, Physics_UpdateTerrainRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PhysicsServer is the server API for Physics service.
 // All implementations must embed UnimplementedPhysicsServer
 // for forward compatibility.
@@ -147,6 +195,10 @@ type PhysicsServer interface {
 	UpdateObjectRadius(context.Context, *UpdateObjectRadiusRequest) (*UpdateObjectRadiusResponse, error)
 	UpdateObjectMassAndRadius(context.Context, *UpdateObjectMassAndRadiusRequest) (*UpdateObjectMassAndRadiusResponse, error)
 	SetPhysicsConfig(context.Context, *SetPhysicsConfigRequest) (*SetPhysicsConfigResponse, error)
+	RemoveObject(context.Context, *RemoveObjectRequest) (*RemoveObjectResponse, error)
+	SetMaterials(context.Context, *SetMaterialsRequest) (*SetMaterialsResponse, error)
+	SetObjectTransform(context.Context, *SetObjectTransformRequest) (*SetObjectTransformResponse, error)
+	UpdateTerrainRegion(context.Context, *UpdateTerrainRegionRequest) (*UpdateTerrainRegionResponse, error)
 	mustEmbedUnimplementedPhysicsServer()
 }
 
@@ -181,6 +233,18 @@ func (UnimplementedPhysicsServer) UpdateObjectMassAndRadius(context.Context, *Up
 func (UnimplementedPhysicsServer) SetPhysicsConfig(context.Context, *SetPhysicsConfigRequest) (*SetPhysicsConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPhysicsConfig not implemented")
 }
+func (UnimplementedPhysicsServer) RemoveObject(context.Context, *RemoveObjectRequest) (*RemoveObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveObject not implemented")
+}
+func (UnimplementedPhysicsServer) SetMaterials(context.Context, *SetMaterialsRequest) (*SetMaterialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaterials not implemented")
+}
+func (UnimplementedPhysicsServer) SetObjectTransform(context.Context, *SetObjectTransformRequest) (*SetObjectTransformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObjectTransform not implemented")
+}
+func (UnimplementedPhysicsServer) UpdateTerrainRegion(context.Context, *UpdateTerrainRegionRequest) (*UpdateTerrainRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTerrainRegion not implemented")
+}
 func (UnimplementedPhysicsServer) mustEmbedUnimplementedPhysicsServer() {}
 func (UnimplementedPhysicsServer) testEmbeddedByValue()                 {}
 
@@ -346,6 +410,78 @@ func _Physics_SetPhysicsConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Physics_RemoveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhysicsServer).RemoveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Physics_RemoveObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhysicsServer).RemoveObject(ctx, req.(*RemoveObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Physics_SetMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaterialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhysicsServer).SetMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Physics_SetMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhysicsServer).SetMaterials(ctx, req.(*SetMaterialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Physics_SetObjectTransform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObjectTransformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhysicsServer).SetObjectTransform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Physics_SetObjectTransform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhysicsServer).SetObjectTransform(ctx, req.(*SetObjectTransformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Physics_UpdateTerrainRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTerrainRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhysicsServer).UpdateTerrainRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Physics_UpdateTerrainRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhysicsServer).UpdateTerrainRegion(ctx, req.(*UpdateTerrainRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Physics_ServiceDesc is the grpc.ServiceDesc for Physics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,6 +521,22 @@ var Physics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPhysicsConfig",
 			Handler:    _Physics_SetPhysicsConfig_Handler,
 		},
+		{
+			MethodName: "RemoveObject",
+			Handler:    _Physics_RemoveObject_Handler,
+		},
+		{
+			MethodName: "SetMaterials",
+			Handler:    _Physics_SetMaterials_Handler,
+		},
+		{
+			MethodName: "SetObjectTransform",
+			Handler:    _Physics_SetObjectTransform_Handler,
+		},
+		{
+			MethodName: "UpdateTerrainRegion",
+			Handler:    _Physics_UpdateTerrainRegion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "physics.proto",
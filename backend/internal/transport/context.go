@@ -0,0 +1,17 @@
+package transport
+
+import "context"
+
+// tickKey ключ контекста с номером тика игрового цикла
+type tickKey struct{}
+
+// WithTick помечает контекст вызовов физики номером тика, в котором их делает система
+func WithTick(ctx context.Context, tick uint64) context.Context {
+	return context.WithValue(ctx, tickKey{}, tick)
+}
+
+// TickFromContext возвращает номер тика, которым помечен контекст вызова
+func TickFromContext(ctx context.Context) (uint64, bool) {
+	tick, ok := ctx.Value(tickKey{}).(uint64)
+	return tick, ok
+}
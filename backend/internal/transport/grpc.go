@@ -2,10 +2,13 @@ package transport
 
 import (
 	"context"
+	"path"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"x-cells/backend/internal/metrics"
 	pb "x-cells/backend/internal/physics/generated"
 )
 
@@ -15,9 +18,11 @@ type grpcPhysicsClient struct {
 	conn   *grpc.ClientConn
 }
 
-func NewPhysicsClient(ctx context.Context, addr string) (IPhysicsClient, error) {
-	conn, err := grpc.DialContext(ctx, addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewPhysicsClient подключается к bullet-server. Дополнительные опции передаются
+// в grpc.DialContext (например, LatencyInterceptor).
+func NewPhysicsClient(ctx context.Context, addr string, opts ...grpc.DialOption) (IPhysicsClient, error) {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -28,6 +33,42 @@ func NewPhysicsClient(ctx context.Context, addr string) (IPhysicsClient, error)
 	}, nil
 }
 
+// LatencyInterceptor записывает длительность каждого вызова физики в latency
+// с меткой по имени метода (CreateObject, ApplyImpulse, ...)
+func LatencyInterceptor(latency *metrics.HistogramVec) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		latency.With(path.Base(method)).ObserveDuration(time.Since(start))
+		return err
+	}
+}
+
+// RPCRecorder получает завершенные вызовы физики, сделанные в тике tick
+// (например, для трассировки тиков)
+type RPCRecorder interface {
+	RecordRPC(tick uint64, method string, start time.Time, duration time.Duration)
+}
+
+// TraceInterceptor передает в recorder вызовы физики, контекст которых помечен
+// тиком (WithTick). Вызовы из других горутин, например из WebSocket-обработчиков,
+// в трассу тика не попадают, даже если завершились во время него.
+func TraceInterceptor(recorder RPCRecorder) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		tick, ok := TickFromContext(ctx)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		recorder.RecordRPC(tick, path.Base(method), start, time.Since(start))
+		return err
+	}
+}
+
 func (c *grpcPhysicsClient) Close() error {
 	return c.conn.Close()
 }
@@ -67,3 +108,23 @@ func (c *grpcPhysicsClient) UpdateObjectMassAndRadius(ctx context.Context, req *
 func (c *grpcPhysicsClient) SetPhysicsConfig(ctx context.Context, req *pb.SetPhysicsConfigRequest, opts ...grpc.CallOption) (*pb.SetPhysicsConfigResponse, error) {
 	return c.client.SetPhysicsConfig(ctx, req, opts...)
 }
+
+// RemoveObject удаляет объект из физического мира
+func (c *grpcPhysicsClient) RemoveObject(ctx context.Context, req *pb.RemoveObjectRequest, opts ...grpc.CallOption) (*pb.RemoveObjectResponse, error) {
+	return c.client.RemoveObject(ctx, req, opts...)
+}
+
+// SetMaterials устанавливает библиотеку материалов и правила контакта
+func (c *grpcPhysicsClient) SetMaterials(ctx context.Context, req *pb.SetMaterialsRequest, opts ...grpc.CallOption) (*pb.SetMaterialsResponse, error) {
+	return c.client.SetMaterials(ctx, req, opts...)
+}
+
+// SetObjectTransform переносит объект в новую позицию
+func (c *grpcPhysicsClient) SetObjectTransform(ctx context.Context, req *pb.SetObjectTransformRequest, opts ...grpc.CallOption) (*pb.SetObjectTransformResponse, error) {
+	return c.client.SetObjectTransform(ctx, req, opts...)
+}
+
+// UpdateTerrainRegion изменяет участок heightmap террейна
+func (c *grpcPhysicsClient) UpdateTerrainRegion(ctx context.Context, req *pb.UpdateTerrainRegionRequest, opts ...grpc.CallOption) (*pb.UpdateTerrainRegionResponse, error) {
+	return c.client.UpdateTerrainRegion(ctx, req, opts...)
+}
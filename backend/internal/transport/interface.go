@@ -18,5 +18,9 @@ type IPhysicsClient interface {
 	UpdateObjectRadius(ctx context.Context, req *pb.UpdateObjectRadiusRequest, opts ...grpc.CallOption) (*pb.UpdateObjectRadiusResponse, error)
 	UpdateObjectMassAndRadius(ctx context.Context, req *pb.UpdateObjectMassAndRadiusRequest, opts ...grpc.CallOption) (*pb.UpdateObjectMassAndRadiusResponse, error)
 	SetPhysicsConfig(ctx context.Context, req *pb.SetPhysicsConfigRequest, opts ...grpc.CallOption) (*pb.SetPhysicsConfigResponse, error)
+	RemoveObject(ctx context.Context, req *pb.RemoveObjectRequest, opts ...grpc.CallOption) (*pb.RemoveObjectResponse, error)
+	SetMaterials(ctx context.Context, req *pb.SetMaterialsRequest, opts ...grpc.CallOption) (*pb.SetMaterialsResponse, error)
+	SetObjectTransform(ctx context.Context, req *pb.SetObjectTransformRequest, opts ...grpc.CallOption) (*pb.SetObjectTransformResponse, error)
+	UpdateTerrainRegion(ctx context.Context, req *pb.UpdateTerrainRegionRequest, opts ...grpc.CallOption) (*pb.UpdateTerrainRegionResponse, error)
 	Close() error
 }
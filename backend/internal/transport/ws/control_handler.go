@@ -33,6 +33,19 @@ type Command struct {
 	}
 }
 
+// InputQueue очередь команд, которые игровой цикл применяет в начале тика.
+// Сила контроллера тоже применяется игровым циклом, каждый тик.
+type InputQueue interface {
+	EnqueueInput(playerID string, seq uint64, cmd string, clientTime int64, impulse *pb.Vector3) bool
+	SetControllerForce(playerID string, force *pb.Vector3)
+}
+
+// SetInputQueue включает применение команд игроков и силы контроллеров в игровом
+// цикле вместо вызовов физики из горутин соединений. Вызывать до Start.
+func (s *WSServer) SetInputQueue(queue InputQueue) {
+	s.inputQueue = queue
+}
+
 // Direction структура для хранения направления
 type Direction struct {
 	X float32 `json:"x"`
@@ -73,10 +86,23 @@ func (s *WSServer) handleCmd(conn *SafeWriter, message interface{}) error {
 	// log.Printf("[Go] Команда для игрока %s", objectID)
 
 	// Обновляем состояние контроллера
-	s.updateControllerState(objectID, cmdMsg)
+	force := s.updateControllerState(objectID, cmdMsg)
 
 	// Вычисляем импульс на основе команды
 	impulse := s.calculateImpulse(cmdMsg, objectID)
+
+	// Команда и сила контроллера применяются в ближайшем тике, подтверждение
+	// уходит после применения (AckInput)
+	if s.inputQueue != nil {
+		if s.acceptsImpulse(objectID) {
+			s.inputQueue.SetControllerForce(objectID, force)
+			if impulse != nil {
+				s.inputQueue.EnqueueInput(objectID, cmdMsg.Seq, cmdMsg.Cmd, cmdMsg.ClientTime, impulse)
+			}
+		}
+		return nil
+	}
+
 	if impulse == nil {
 		return nil
 	}
@@ -92,17 +118,18 @@ func (s *WSServer) handleCmd(conn *SafeWriter, message interface{}) error {
 	return s.simulateNetworkConditions(conn, ackMsg)
 }
 
-// updateControllerState обновляет состояние контроллера для объекта
-func (s *WSServer) updateControllerState(objectID string, cmdMsg *CommandMessage) {
+// updateControllerState обновляет состояние контроллера для объекта и возвращает его силу
+func (s *WSServer) updateControllerState(objectID string, cmdMsg *CommandMessage) *pb.Vector3 {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if state, exists := s.controllerStates[objectID]; exists {
+	state, exists := s.controllerStates[objectID]
+	if exists {
 		state.LastUpdate = time.Now()
 		s.updateForceFromData(state, cmdMsg.Data)
 	} else {
 		// Создаем новое состояние контроллера
-		state := &ControllerState{
+		state = &ControllerState{
 			LastUpdate: time.Now(),
 			Force: struct {
 				X float32
@@ -113,6 +140,7 @@ func (s *WSServer) updateControllerState(objectID string, cmdMsg *CommandMessage
 		s.updateForceFromData(state, cmdMsg.Data)
 		s.controllerStates[objectID] = state
 	}
+	return &pb.Vector3{X: state.Force.X, Y: state.Force.Y, Z: state.Force.Z}
 }
 
 // updateForceFromData обновляет силу из данных команды
@@ -181,7 +209,7 @@ func (s *WSServer) calculateMouseVectorImpulse(cmdMsg *CommandMessage, objectID
 	//	objectID, direction.X, direction.Y, direction.Z, direction.Distance)
 
 	// Используем настройки из конфигурации физики
-	physicsConfig := world.GetPhysicsConfig()
+	physicsConfig := s.physicsConfig
 
 	// Создаем импульс в направлении X, Y и Z с учетом полученного вектора
 	impulse := &pb.Vector3{
@@ -196,18 +224,25 @@ func (s *WSServer) calculateMouseVectorImpulse(cmdMsg *CommandMessage, objectID
 	return impulse
 }
 
-// applyImpulseToObject применяет импульс к объекту
-func (s *WSServer) applyImpulseToObject(objectID string, impulse *pb.Vector3) error {
-	// Проверяем, что объект игрока существует
+// acceptsImpulse проверяет, что объект игрока существует и управляется сервером
+func (s *WSServer) acceptsImpulse(objectID string) bool {
 	obj, exists := s.objectManager.GetObject(objectID)
 	if !exists {
 		log.Printf("[Go] Объект игрока %s не найден", objectID)
-		return nil
+		return false
 	}
 
 	// Пропускаем объекты, которые обрабатываются только на клиенте
 	if obj.PhysicsType == world.PhysicsTypeAmmo {
 		log.Printf("[Go] Объект %s имеет тип физики ammo, пропускаем", objectID)
+		return false
+	}
+	return true
+}
+
+// applyImpulseToObject применяет импульс к объекту
+func (s *WSServer) applyImpulseToObject(objectID string, impulse *pb.Vector3) error {
+	if !s.acceptsImpulse(objectID) {
 		return nil
 	}
 
@@ -228,7 +263,25 @@ func (s *WSServer) applyImpulseToObject(objectID string, impulse *pb.Vector3) er
 	return nil
 }
 
-// applyImpulses регулярно применяет импульсы к объектам на основе состояния контроллеров
+// AckInput подтверждает игроку команду, примененную в тике игрового цикла
+func (s *WSServer) AckInput(playerID, cmd string, seq uint64, clientTime int64, tick uint64) {
+	s.playersMu.RLock()
+	player, ok := s.players[playerID]
+	s.playersMu.RUnlock()
+	if !ok {
+		return
+	}
+
+	ackMsg := NewAckMessage(cmd, clientTime)
+	ackMsg.Seq = seq
+	ackMsg.Tick = tick
+	if err := s.simulateNetworkConditions(player.Conn, ackMsg); err != nil {
+		log.Printf("[WSServer] Ошибка отправки подтверждения команды игроку %s: %v", playerID, err)
+	}
+}
+
+// applyImpulses регулярно применяет импульсы к объектам на основе состояния контроллеров.
+// Работает только без очереди ввода: с ней силу контроллеров применяет игровой цикл.
 func (s *WSServer) applyImpulses() {
 	ticker := time.NewTicker(s.impulseInterval)
 	defer ticker.Stop()
@@ -270,6 +323,37 @@ func (s *WSServer) handlePing(conn *SafeWriter, message interface{}) error {
 	return s.simulateNetworkConditions(conn, pongMessage)
 }
 
+// LatencyRecorder принимает замеры задержки клиентов для компенсации задержки
+type LatencyRecorder interface {
+	SetPlayerLatency(playerID string, rtt time.Duration)
+}
+
+// maxMeasuredRTT замеры больше считаются ошибочными (чужой или очень старый pong)
+const maxMeasuredRTT = 10 * time.Second
+
+// handlePong измеряет RTT по ответу клиента на пинг сервера: клиент возвращает
+// server_time из пинга, поэтому расхождение часов клиента и сервера не мешает
+func (s *WSServer) handlePong(conn *SafeWriter, message interface{}) error {
+	pongMsg, ok := message.(*PongMessage)
+	if !ok {
+		return ErrInvalidMessage
+	}
+
+	rtt := time.Duration(GetCurrentServerTime()-pongMsg.ServerTime) * time.Millisecond
+	if pongMsg.ServerTime == 0 || rtt < 0 || rtt > maxMeasuredRTT {
+		return nil
+	}
+
+	player := s.getPlayerByConnection(conn)
+	if player == nil {
+		return nil
+	}
+	if recorder, ok := s.gameTicker.(LatencyRecorder); ok {
+		recorder.SetPlayerLatency(player.ObjectID, rtt)
+	}
+	return nil
+}
+
 // startPing запускает периодическую отправку пингов для проверки соединения
 func (s *WSServer) startPing(conn *SafeWriter) {
 	ticker := time.NewTicker(s.pingInterval)
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"time"
+
+	"x-cells/backend/internal/world"
 )
 
 // Ошибки при разборе сообщений
@@ -77,6 +79,120 @@ func NewInfoMessage(message string) *InfoMessage {
 	}
 }
 
+// NewDeleteMessage создает сообщение об удалении объекта
+func NewDeleteMessage(id string, reason string) *DeleteMessage {
+	return &DeleteMessage{
+		Type:       MessageTypeDelete,
+		ID:         id,
+		Reason:     reason,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
+// NewOutOfBoundsMessage создает сообщение о выходе игрока за границы мира
+func NewOutOfBoundsMessage(event world.BoundsEvent) *OutOfBoundsMessage {
+	return &OutOfBoundsMessage{
+		Type:       MessageTypeOutOfBounds,
+		PlayerID:   event.PlayerID,
+		ObjectID:   event.ObjectID,
+		Action:     string(event.Action),
+		Reason:     string(event.Reason),
+		X:          event.To.X,
+		Y:          event.To.Y,
+		Z:          event.To.Z,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
+// NewPlayerEatenMessage создает сообщение о поедании игрока
+func NewPlayerEatenMessage(eaterID, victimID string, massGain float64) *PlayerEatenMessage {
+	return &PlayerEatenMessage{
+		Type:       MessageTypePlayerEaten,
+		EaterID:    eaterID,
+		VictimID:   victimID,
+		MassGain:   massGain,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
+// NewPlayerDiedMessage создает сообщение о гибели игрока
+func NewPlayerDiedMessage(playerID, killerID string) *PlayerDiedMessage {
+	return &PlayerDiedMessage{
+		Type:       MessageTypePlayerDied,
+		PlayerID:   playerID,
+		KillerID:   killerID,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
+// NewShutdownMessage создает сообщение об остановке сервера
+func NewShutdownMessage(reason string) *ShutdownMessage {
+	return &ShutdownMessage{
+		Type:       MessageTypeShutdown,
+		Reason:     reason,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
+// NewTerrainInfoMessage создает сообщение с метаданными террейна для потоковой передачи чанками
+func NewTerrainInfoMessage(obj *world.WorldObject, chunkSize int32, lodLevels int) *TerrainInfoMessage {
+	terrain := obj.Shape.Terrain
+	chunksX, chunksZ := terrain.ChunkGrid(chunkSize)
+
+	return &TerrainInfoMessage{
+		Type:       MessageTypeTerrainInfo,
+		ID:         obj.ID,
+		X:          obj.Position.X,
+		Y:          obj.Position.Y,
+		Z:          obj.Position.Z,
+		Color:      obj.Color,
+		PhysicsBy:  string(obj.PhysicsType),
+		HeightmapW: terrain.Width,
+		HeightmapH: terrain.Depth,
+		ScaleX:     terrain.ScaleX,
+		ScaleY:     terrain.ScaleY,
+		ScaleZ:     terrain.ScaleZ,
+		MinHeight:  obj.MinHeight,
+		MaxHeight:  obj.MaxHeight,
+		ChunkSize:  chunkSize,
+		ChunksX:    chunksX,
+		ChunksZ:    chunksZ,
+		LODLevels:  lodLevels,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
+// NewTerrainChunkMessage создает сообщение с высотами чанка террейна
+func NewTerrainChunkMessage(terrainID string, chunk *world.TerrainChunk) *TerrainChunkMessage {
+	return &TerrainChunkMessage{
+		Type:      MessageTypeTerrainChunk,
+		TerrainID: terrainID,
+		ChunkX:    chunk.ChunkX,
+		ChunkZ:    chunk.ChunkZ,
+		LOD:       chunk.LOD,
+		Step:      chunk.Step,
+		OffsetX:   chunk.OffsetX,
+		OffsetZ:   chunk.OffsetZ,
+		Width:     chunk.Width,
+		Depth:     chunk.Depth,
+		Heights:   chunk.Heights,
+	}
+}
+
+// NewTerrainPatchMessage создает сообщение с измененным участком террейна
+func NewTerrainPatchMessage(patch world.TerrainPatch) *TerrainPatchMessage {
+	return &TerrainPatchMessage{
+		Type:       MessageTypeTerrainPatch,
+		TerrainID:  patch.TerrainID,
+		OffsetX:    patch.OffsetX,
+		OffsetZ:    patch.OffsetZ,
+		Width:      patch.Width,
+		Depth:      patch.Depth,
+		Heights:    patch.Heights,
+		ServerTime: GetCurrentServerTime(),
+	}
+}
+
 // ParseMessage разбирает сырые данные в соответствующую структуру сообщения
 func ParseMessage(data []byte) (interface{}, error) {
 	// Сначала получаем тип сообщения
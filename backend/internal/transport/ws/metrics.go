@@ -0,0 +1,30 @@
+package ws
+
+import (
+	"sort"
+
+	"x-cells/backend/internal/metrics"
+)
+
+// WriteMetrics пишет метрики WebSocket-соединений в формате Prometheus
+func (s *WSServer) WriteMetrics(e *metrics.Exposition) {
+	s.playersMu.RLock()
+	depths := make(map[string]int, len(s.players))
+	for id, player := range s.players {
+		depths[id] = player.Conn.QueueDepth()
+	}
+	s.playersMu.RUnlock()
+
+	ids := make([]string, 0, len(depths))
+	for id := range depths {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	e.Gauge("xcells_ws_connections", "Подключенные игроки", float64(len(depths)))
+	e.Header("xcells_ws_send_queue_depth", "gauge", "Сообщения в очереди на отправку по соединению")
+	for _, id := range ids {
+		e.Sample("xcells_ws_send_queue_depth", float64(depths[id]), metrics.Label{Name: "connection", Value: id})
+	}
+	e.Gauge("xcells_ws_delayed_messages", "Сообщения, задержанные имитацией сети", float64(len(s.delayedMessages)))
+}
@@ -1,6 +1,9 @@
 package ws
 
 import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"math/rand/v2"
@@ -9,6 +12,14 @@ import (
 	"x-cells/backend/internal/world"
 )
 
+const (
+	spawnDropHeight     = float32(5.0)  // Высота над землей, с которой игрок падает при спавне
+	spawnFallbackHeight = float32(80.0) // Высота спавна, если террейн недоступен
+
+	// playerRemoveTimeout ограничивает удаление тела игрока из Bullet, как DespawnTimeout в game
+	playerRemoveTimeout = 200 * time.Millisecond
+)
+
 // PlayerManager интерфейс для управления игроками в игровых системах
 type PlayerManager interface {
 	AddPlayerFromWorldObject(playerID string, worldObject *world.WorldObject) error
@@ -24,8 +35,9 @@ type PlayerConnection struct {
 
 // PlayerCreationRequest представляет запрос на создание игрока
 type PlayerCreationRequest struct {
-	Conn     *SafeWriter
-	Response chan *PlayerCreationResponse
+	Conn        *SafeWriter
+	ResumeToken string // Секретный токен прошлой сессии (пустой - новый игрок)
+	Response    chan *PlayerCreationResponse
 }
 
 // PlayerCreationResponse представляет ответ на создание игрока
@@ -39,23 +51,34 @@ func (s *WSServer) generatePlayerID() string {
 	return fmt.Sprintf("player_%d_%d", time.Now().UnixNano(), rand.IntN(10000))
 }
 
+// generateResumeToken создает секретный токен возобновления сессии
+func generateResumeToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := crand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
 // createPlayerObject создает объект игрока в мире
 func (s *WSServer) createPlayerObject(playerID string) (*world.WorldObject, error) {
 	if s.factory == nil {
 		return nil, fmt.Errorf("factory не инициализирован")
 	}
 
-	// Получаем максимальную высоту террейна для размещения игрока
-	terrainMaxHeight := float32(30.0) // Используем константу из test_objects.go
-
 	// Все игроки появляются в случайных позициях
 	spawnX := float32(rand.IntN(200) - 100) // от -100 до 100
 	spawnZ := float32(rand.IntN(200) - 100) // от -100 до 100
-	spawnY := terrainMaxHeight + 50
 
 	// Генерируем случайный радиус (2.0 - 20.0)
 	radius := float32(2.0 + rand.Float64()*18.0)
 
+	// Ставим игрока над поверхностью террейна в точке спавна
+	spawnY := spawnFallbackHeight
+	if groundHeight, ok := s.objectManager.GroundHeightAt(spawnX, spawnZ); ok {
+		spawnY = groundHeight + radius + spawnDropHeight
+	}
+
 	// Простая линейная зависимость: масса = радиус * коэффициент
 	// При радиусе 100 → масса 100 кг, значит коэффициент = 1.0
 	massCoeff := float32(1.0)
@@ -72,7 +95,7 @@ func (s *WSServer) createPlayerObject(playerID string) (*world.WorldObject, erro
 	color := colors[rand.IntN(len(colors))]
 
 	// Создаем сферу игрока с индивидуальным скиллом прыгучести, используя playerID как ID объекта
-	playerSphere := world.NewPlayerWithBounceSkill(
+	playerSphere := s.factory.NewPlayerWithBounceSkill(
 		playerID, // используем playerID как ID объекта
 		world.Vector3{X: spawnX, Y: spawnY, Z: spawnZ},
 		radius, // Случайный радиус
@@ -100,20 +123,64 @@ func (s *WSServer) createPlayerObject(playerID string) (*world.WorldObject, erro
 	return playerSphere, nil
 }
 
-// removePlayerObject удаляет объект игрока из мира
+// removePlayerObject удаляет объект игрока из мира и из Bullet: иначе тело отключившегося
+// игрока продолжает сталкиваться с остальными, а повторное использование ID оставляет его сиротой
 func (s *WSServer) removePlayerObject(playerID string) error {
-	// Удаляем объект из менеджера мира
-	s.objectManager.RemoveObject(playerID)
+	obj, ok := s.objectManager.GetObject(playerID)
+	if !ok {
+		// Объект уже удален, например, игрок был съеден
+		return nil
+	}
 
-	// TODO: Добавить удаление из Bullet Physics когда будет доступен соответствующий метод
+	if s.factory == nil {
+		s.objectManager.RemoveObject(playerID)
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), playerRemoveTimeout)
+		defer cancel()
+		if err := s.factory.RemoveObject(ctx, obj); err != nil {
+			return err
+		}
+	}
 
 	log.Printf("[WSServer] Удален объект игрока %s", playerID)
 	return nil
 }
 
+// RestoredPlayers интерфейс для показателей игроков, восстановленных из сохранения.
+// Показатели возвращаются по секретному токену: ID игрока рассылается всем клиентам
+// как ID его объекта и не может служить доказательством владения.
+type RestoredPlayers interface {
+	RestoredPlayerByToken(token string) (playerID string, ok bool)
+	SetResumeToken(playerID, token string)
+}
+
+// resolvePlayerID возвращает ID для нового подключения. ID игрока из сохранения
+// выдается только по его токену и только если под этим ID сейчас никто не играет:
+// так игрок возвращает свои показатели после перезапуска сервера.
+func (s *WSServer) resolvePlayerID(resumeToken string) string {
+	restored, ok := s.gameTicker.(RestoredPlayers)
+	if !ok {
+		return s.generatePlayerID()
+	}
+
+	requestedID, ok := restored.RestoredPlayerByToken(resumeToken)
+	if !ok {
+		return s.generatePlayerID()
+	}
+
+	s.playersMu.RLock()
+	_, taken := s.players[requestedID]
+	s.playersMu.RUnlock()
+
+	if taken {
+		return s.generatePlayerID()
+	}
+	return requestedID
+}
+
 // addPlayer добавляет нового игрока при подключении
-func (s *WSServer) addPlayer(conn *SafeWriter) (*PlayerConnection, error) {
-	playerID := s.generatePlayerID()
+func (s *WSServer) addPlayer(conn *SafeWriter, resumeToken string) (*PlayerConnection, error) {
+	playerID := s.resolvePlayerID(resumeToken)
 
 	// Создаем объект игрока в мире, используя playerID
 	playerSphere, err := s.createPlayerObject(playerID)
@@ -144,21 +211,97 @@ func (s *WSServer) addPlayer(conn *SafeWriter) (*PlayerConnection, error) {
 		log.Printf("[WSServer] Ошибка отправки информации игроку %s: %v", playerID, err)
 	}
 
-	// Отправляем клиенту его player ID для использования в командах
+	s.sendPlayerID(conn, playerID)
+
+	return player, nil
+}
+
+// sendPlayerID выдает игроку новый токен возобновления и отправляет клиенту его
+// player ID для использования в командах
+func (s *WSServer) sendPlayerID(conn *SafeWriter, playerID string) {
+	// Новый токен на каждое подключение и возрождение: старый перестает действовать
+	newToken, err := generateResumeToken()
+	if err != nil {
+		log.Printf("[WSServer] Ошибка создания токена возобновления игроку %s: %v", playerID, err)
+	}
+	if restored, ok := s.gameTicker.(RestoredPlayers); ok && newToken != "" {
+		restored.SetResumeToken(playerID, newToken)
+	}
+
 	playerIDMsg := map[string]interface{}{
 		"type":      "player_id",
 		"player_id": playerID,
 		"object_id": playerID, // теперь object_id = player_id
 	}
+	if newToken != "" {
+		playerIDMsg["resume_token"] = newToken // Только этому клиенту
+	}
 	if err := conn.WriteJSON(playerIDMsg); err != nil {
 		log.Printf("[WSServer] Ошибка отправки player_id игроку %s: %v", playerID, err)
 	}
+}
 
-	return player, nil
+// HandlePlayerDied снимает управление с погибшего (съеденного или упавшего ниже
+// плоскости смерти) игрока и возрождает его в том же
+// соединении под тем же ID. Возрождение идет вне игрового цикла, по очереди с
+// созданием игроков; если объект создать не удалось, соединение закрывается.
+func (s *WSServer) HandlePlayerDied(playerID string) {
+	s.mu.Lock()
+	delete(s.controllerStates, playerID)
+	s.mu.Unlock()
+	if s.inputQueue != nil {
+		s.inputQueue.SetControllerForce(playerID, nil)
+	}
+
+	s.playersMu.RLock()
+	player, ok := s.players[playerID]
+	s.playersMu.RUnlock()
+	if !ok {
+		return
+	}
+
+	go s.respawnPlayer(player)
 }
 
-// removePlayer удаляет игрока при отключении
+// respawnPlayer создает погибшему игроку новый объект и рассылает его клиентам
+func (s *WSServer) respawnPlayer(player *PlayerConnection) {
+	s.queueWorkerMu.Lock()
+	defer s.queueWorkerMu.Unlock()
+
+	// Игрок мог отключиться, пока ждал очереди
+	if s.getPlayerByConnection(player.Conn) != player {
+		return
+	}
+
+	playerSphere, err := s.createPlayerObject(player.ObjectID)
+	if err != nil {
+		log.Printf("[WSServer] Не удалось возродить игрока %s, соединение закрывается: %v", player.ID, err)
+		player.Conn.Close()
+		return
+	}
+	if err := s.addPlayerToGameTicker(player.ID, playerSphere); err != nil {
+		log.Printf("[WSServer] ОШИБКА добавления возрожденного игрока %s в GameTicker: %v", player.ID, err)
+	}
+
+	s.sendPlayerID(player.Conn, player.ID)
+
+	s.playersMu.RLock()
+	for _, existingPlayer := range s.players {
+		if err := s.serializer.SendCreateForObject(existingPlayer.Conn, player.ObjectID); err != nil {
+			log.Printf("[WSServer] Ошибка отправки объекта игрока %s клиенту %s: %v", player.ObjectID, existingPlayer.ID, err)
+		}
+	}
+	s.playersMu.RUnlock()
+
+	log.Printf("[WSServer] Игрок %s возрожден", player.ID)
+}
+
+// removePlayer удаляет игрока при отключении. Ждет очередь создания игроков, чтобы
+// не удалить игрока посреди его возрождения.
 func (s *WSServer) removePlayer(conn *SafeWriter) {
+	s.queueWorkerMu.Lock()
+	defer s.queueWorkerMu.Unlock()
+
 	s.playersMu.Lock()
 	defer s.playersMu.Unlock()
 
@@ -221,7 +364,7 @@ func (s *WSServer) playerCreationWorker() {
 		s.queueWorkerMu.Lock()
 
 		// Создаем игрока последовательно, используя существующий метод
-		player, err := s.addPlayer(request.Conn)
+		player, err := s.addPlayer(request.Conn, request.ResumeToken)
 
 		// Отправляем ответ
 		response := &PlayerCreationResponse{
@@ -0,0 +1,227 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+
+	pb "x-cells/backend/internal/physics/generated"
+	"x-cells/backend/internal/world"
+)
+
+type recordingInputQueue struct {
+	mu     sync.Mutex
+	forces map[string]*pb.Vector3
+}
+
+func (q *recordingInputQueue) EnqueueInput(playerID string, seq uint64, cmd string, clientTime int64, impulse *pb.Vector3) bool {
+	return true
+}
+
+func (q *recordingInputQueue) SetControllerForce(playerID string, force *pb.Vector3) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.forces[playerID] = force
+}
+
+func TestWSServer_PlayerDiedClearsControllerAndDisconnectsWithoutRespawn(t *testing.T) {
+	queue := &recordingInputQueue{forces: map[string]*pb.Vector3{"victim": {X: 1}}}
+	s := &WSServer{
+		players:          make(map[string]*PlayerConnection),
+		controllerStates: map[string]*ControllerState{"victim": {}},
+		inputQueue:       queue,
+	}
+
+	registered := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Failed to upgrade connection: %v", err)
+			return
+		}
+		safeConn := NewSafeWriter(conn)
+		s.playersMu.Lock()
+		s.players["victim"] = &PlayerConnection{ID: "victim", ObjectID: "victim", Conn: safeConn}
+		s.playersMu.Unlock()
+		close(registered)
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket server: %v", err)
+	}
+	defer client.Close()
+	<-registered
+
+	s.HandlePlayerDied("victim")
+
+	s.mu.RLock()
+	_, hasState := s.controllerStates["victim"]
+	s.mu.RUnlock()
+	if hasState {
+		t.Error("Состояние контроллера съеденного игрока должно быть удалено")
+	}
+	queue.mu.Lock()
+	force := queue.forces["victim"]
+	queue.mu.Unlock()
+	if force != nil {
+		t.Errorf("Сила контроллера съеденного игрока должна быть снята, получили %+v", force)
+	}
+
+	// Без фабрики объект не создать: вместо возрождения соединение закрывается
+	client.SetReadDeadline(time.Now().Add(2 * time.Second))
+	if _, _, err := client.ReadMessage(); err == nil || strings.Contains(err.Error(), "timeout") {
+		t.Errorf("Ожидали закрытие соединения, получили %v", err)
+	}
+}
+
+// recordingPhysics запоминает объекты, созданные в Bullet
+type recordingPhysics struct {
+	pb.PhysicsClient
+
+	mu      sync.Mutex
+	created []string
+}
+
+func (p *recordingPhysics) CreateObject(ctx context.Context, req *pb.CreateObjectRequest, opts ...grpc.CallOption) (*pb.CreateObjectResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.created = append(p.created, req.Id)
+	return &pb.CreateObjectResponse{Status: "OK"}, nil
+}
+
+// recordingTicker принимает игроков из WSServer и хранит их токены возобновления
+type recordingTicker struct {
+	mu     sync.Mutex
+	added  []string
+	tokens map[string]string
+}
+
+func (t *recordingTicker) AddPlayerFromWorldObject(playerID string, worldObject *world.WorldObject) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.added = append(t.added, playerID)
+	return nil
+}
+
+func (t *recordingTicker) RestoredPlayerByToken(token string) (string, bool) {
+	return "", false
+}
+
+func (t *recordingTicker) SetResumeToken(playerID, token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tokens[playerID] = token
+}
+
+// readMessageOfType читает сообщения клиента, пока не придет сообщение нужного типа
+func readMessageOfType(t *testing.T, client *websocket.Conn, msgType string) map[string]interface{} {
+	t.Helper()
+	client.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		var msg map[string]interface{}
+		if err := client.ReadJSON(&msg); err != nil {
+			t.Fatalf("Не дождались сообщения %s: %v", msgType, err)
+		}
+		if msg["type"] == msgType {
+			return msg
+		}
+	}
+}
+
+func TestWSServer_PlayerDiedRespawnsUnderSameID(t *testing.T) {
+	manager := world.NewManager()
+	physics := &recordingPhysics{}
+	factory := world.NewFactory(manager, physics, world.DefaultPhysicsConfig())
+	s := NewWSServer(manager, factory, nil, NewWorldSerializer(manager), world.DefaultPhysicsConfig())
+	ticker := &recordingTicker{tokens: map[string]string{"victim": "old-token"}}
+	s.SetGameTicker(ticker)
+
+	// Игрок подключения задается параметром ?id=
+	registered := make(chan struct{}, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Failed to upgrade connection: %v", err)
+			return
+		}
+		id := r.URL.Query().Get("id")
+		s.playersMu.Lock()
+		s.players[id] = &PlayerConnection{ID: id, ObjectID: id, Conn: NewSafeWriter(conn)}
+		s.playersMu.Unlock()
+		registered <- struct{}{}
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	dial := func(id string) *websocket.Conn {
+		client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"?id="+id, nil)
+		if err != nil {
+			t.Fatalf("Failed to connect to WebSocket server: %v", err)
+		}
+		<-registered
+		return client
+	}
+	victim := dial("victim")
+	defer victim.Close()
+	watcher := dial("watcher")
+	defer watcher.Close()
+
+	s.HandlePlayerDied("victim")
+
+	// Погибший получает свой прежний ID с новым токеном возобновления
+	playerID := readMessageOfType(t, victim, "player_id")
+	token, _ := playerID["resume_token"].(string)
+	if playerID["player_id"] != "victim" || playerID["object_id"] != "victim" {
+		t.Errorf("Игрок должен возродиться под тем же ID, получили %v", playerID)
+	}
+	ticker.mu.Lock()
+	storedToken := ticker.tokens["victim"]
+	added := append([]string(nil), ticker.added...)
+	ticker.mu.Unlock()
+	if token == "" || token == "old-token" || token != storedToken {
+		t.Errorf("Ожидали новый токен возобновления, клиент получил %q, сохранен %q", token, storedToken)
+	}
+
+	// Новый объект создан в мире, в Bullet и в GameTicker под тем же ID
+	if _, ok := manager.GetObject("victim"); !ok {
+		t.Error("Объект возрожденного игрока должен появиться в мире")
+	}
+	physics.mu.Lock()
+	created := append([]string(nil), physics.created...)
+	physics.mu.Unlock()
+	if len(created) != 1 || created[0] != "victim" {
+		t.Errorf("Ожидали создание объекта victim в Bullet, получили %v", created)
+	}
+	if len(added) != 1 || added[0] != "victim" {
+		t.Errorf("Ожидали добавление victim в GameTicker, получили %v", added)
+	}
+
+	// create нового объекта получают все клиенты
+	for name, client := range map[string]*websocket.Conn{"victim": victim, "watcher": watcher} {
+		if create := readMessageOfType(t, client, "create"); create["id"] != "victim" {
+			t.Errorf("Клиент %s получил create для %v вместо victim", name, create["id"])
+		}
+	}
+}
@@ -2,6 +2,7 @@ package ws
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/gorilla/websocket"
 )
@@ -9,8 +10,9 @@ import (
 // SafeWriter - потокобезопасная обертка для WebSocket соединения
 // Позволяет безопасно писать в WebSocket из нескольких горутин
 type SafeWriter struct {
-	conn  *websocket.Conn
-	mutex sync.Mutex
+	conn    *websocket.Conn
+	mutex   sync.Mutex
+	pending atomic.Int32 // Сообщения, ожидающие записи или записываемые сейчас
 }
 
 // NewSafeWriter создает новый экземпляр потокобезопасного райтера для WebSocket
@@ -23,6 +25,9 @@ func NewSafeWriter(conn *websocket.Conn) *SafeWriter {
 
 // WriteJSON безопасно отправляет JSON данные через WebSocket соединение
 func (w *SafeWriter) WriteJSON(data interface{}) error {
+	w.pending.Add(1)
+	defer w.pending.Add(-1)
+
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
@@ -31,12 +36,21 @@ func (w *SafeWriter) WriteJSON(data interface{}) error {
 
 // WriteMessage безопасно отправляет сообщение через WebSocket соединение
 func (w *SafeWriter) WriteMessage(messageType int, data []byte) error {
+	w.pending.Add(1)
+	defer w.pending.Add(-1)
+
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
 	return w.conn.WriteMessage(messageType, data)
 }
 
+// QueueDepth возвращает число сообщений в очереди на отправку: горутины,
+// ожидающие записи в соединение, плюс записываемое сейчас сообщение
+func (w *SafeWriter) QueueDepth() int {
+	return int(w.pending.Load())
+}
+
 // Close закрывает WebSocket соединение
 func (w *SafeWriter) Close() error {
 	return w.conn.Close()
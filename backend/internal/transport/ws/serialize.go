@@ -22,9 +22,29 @@ func NewWorldSerializer(worldManager *world.Manager) *WorldSerializer {
 
 // SendCreateForAllObjects отправляет информацию о всех объектах клиенту
 func (s *WorldSerializer) SendCreateForAllObjects(wsWriter *SafeWriter) error {
+	return s.sendCreateForAllObjects(wsWriter, nil)
+}
+
+// SendCreateForAllObjectsChunked отправляет информацию о всех объектах клиенту,
+// заменяя heightmap террейна метаданными terrain_info (высоты придут чанками)
+func (s *WorldSerializer) SendCreateForAllObjectsChunked(wsWriter *SafeWriter, streaming TerrainStreamingConfig) error {
+	return s.sendCreateForAllObjects(wsWriter, &streaming)
+}
+
+// sendCreateForAllObjects отправляет объекты клиенту; при streaming != nil террейн передается без высот
+func (s *WorldSerializer) sendCreateForAllObjects(wsWriter *SafeWriter, streaming *TerrainStreamingConfig) error {
 	worldObjects := s.worldManager.GetAllWorldObjects()
 
 	for _, obj := range worldObjects {
+		if streaming != nil && obj.Shape.Type == world.TERRAIN {
+			msg := NewTerrainInfoMessage(obj, streaming.ChunkSize, streaming.LODLevels())
+			if err := wsWriter.WriteJSON(msg); err != nil {
+				log.Printf("[Serialize] Ошибка отправки метаданных террейна %s: %v", obj.ID, err)
+				return err
+			}
+			continue
+		}
+
 		// Получаем текущее время в миллисекундах для временной метки
 		serverTime := time.Now().UnixNano() / int64(time.Millisecond)
 
@@ -55,8 +75,9 @@ func (s *WorldSerializer) SendCreateForAllObjects(wsWriter *SafeWriter) error {
 			msg["mass"] = obj.Shape.Box.Mass
 
 		case world.TERRAIN:
+			// Копия высот: DeformTerrain может менять их во время отправки
 			msg["object_type"] = "terrain"
-			msg["height_data"] = obj.Shape.Terrain.HeightData
+			msg["height_data"] = s.worldManager.CopyTerrainObject(obj).Shape.Terrain.HeightData
 			msg["heightmap_w"] = obj.Shape.Terrain.Width
 			msg["heightmap_h"] = obj.Shape.Terrain.Depth
 			msg["scale_x"] = obj.Shape.Terrain.ScaleX
@@ -145,8 +166,9 @@ func (s *WorldSerializer) SendCreateForObject(wsWriter *SafeWriter, objectID str
 		msg["mass"] = obj.Shape.Box.Mass
 
 	case world.TERRAIN:
+		// Копия высот: DeformTerrain может менять их во время отправки
 		msg["object_type"] = "terrain"
-		msg["height_data"] = obj.Shape.Terrain.HeightData
+		msg["height_data"] = s.worldManager.CopyTerrainObject(obj).Shape.Terrain.HeightData
 		msg["heightmap_w"] = obj.Shape.Terrain.Width
 		msg["heightmap_h"] = obj.Shape.Terrain.Depth
 		msg["scale_x"] = obj.Shape.Terrain.ScaleX
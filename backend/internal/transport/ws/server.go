@@ -24,6 +24,8 @@ type ObjectManager interface {
 	UpdateObjectRotation(id string, rotation world.Quaternion)
 	AddWorldObject(obj *world.WorldObject)
 	RemoveObject(id string)
+	GroundHeightAt(x, z float32) (float32, bool)
+	TerrainChunk(terrainID string, chunkX, chunkZ, chunkSize int32, lod int) (*world.TerrainChunk, bool)
 }
 
 // MessageHandler - тип функции обработчика сообщений
@@ -56,20 +58,33 @@ type WSServer struct {
 	delayedMessages chan DelayedMessage
 	simMu           sync.RWMutex // мьютекс для настроек симуляции
 
-	// === НОВОЕ: Поддержка системы еды ===
-	foodSystem interface{} // Ссылка на систему еды (интерфейс для гибкости)
-
 	// === НОВОЕ: Поддержка GameTicker ===
 	gameTicker interface{} // Ссылка на GameTicker для управления игроками
+	inputQueue InputQueue  // Очередь команд игрового цикла; nil - импульс применяется сразу
+
+	// Потоковая передача террейна чанками (для клиентов с ?terrain=chunks)
+	terrainStreaming TerrainStreamingConfig
+
+	// Итоговая конфигурация физики комнаты (импульсы управления, параметры для клиентов)
+	physicsConfig world.PhysicsConfig
+
+	// Открытые соединения и остановка сервера
+	connsMu sync.Mutex
+	conns   map[*SafeWriter]struct{}
+	active  sync.WaitGroup // Активные обработчики HandleWS
+	closing bool
 }
 
-// NewWSServer создает новый экземпляр WebSocket сервера
-func NewWSServer(objectManager ObjectManager, physics transport.IPhysicsClient, serialaizer *WorldSerializer) *WSServer {
+// NewWSServer создает новый экземпляр WebSocket сервера. factory - общая фабрика мира
+// (с библиотекой материалов), через нее сервер создает объекты игроков.
+func NewWSServer(objectManager ObjectManager, factory *world.Factory, physics transport.IPhysicsClient,
+	serialaizer *WorldSerializer, physicsConfig world.PhysicsConfig) *WSServer {
 	server := &WSServer{
 		upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool { return true },
 		},
 		objectManager:      objectManager,
+		factory:            factory,
 		physics:            physics,
 		handlers:           make(map[string]MessageHandler),
 		connectionHandlers: []func(conn *SafeWriter){},
@@ -97,21 +112,20 @@ func NewWSServer(objectManager ObjectManager, physics transport.IPhysicsClient,
 		// Очередь создания игроков
 		playerQueue:   make(chan *PlayerCreationRequest, 100),
 		queueWorkerMu: sync.Mutex{},
+
+		terrainStreaming: DefaultTerrainStreamingConfig(),
+		physicsConfig:    physicsConfig,
+		conns:            make(map[*SafeWriter]struct{}),
 	}
 
-	// Создаем factory после инициализации сервера
-	// Нужно привести objectManager к типу *world.Manager
-	if manager, ok := objectManager.(*world.Manager); ok {
-		server.factory = world.NewFactory(manager, physics)
-		// Устанавливаем factory в manager для обратного доступа
-		manager.SetFactory(server.factory)
-	} else {
-		log.Printf("[WSServer] Предупреждение: objectManager не является *world.Manager, factory не создан")
+	if factory == nil {
+		log.Printf("[WSServer] Предупреждение: factory не передан, игроки не будут создаваться")
 	}
 
 	// Регистрируем стандартные обработчики
 	server.RegisterHandler(MessageTypePing, server.handlePing)
 	server.RegisterHandler(MessageTypeCommand, server.handleCmd)
+	server.RegisterHandler(MessageTypePong, server.handlePong)
 
 	// Запускаем обработчик отложенных сообщений
 	go server.processDelayedMessages()
@@ -139,6 +153,12 @@ func (s *WSServer) SetPingInterval(interval time.Duration) {
 
 // HandleWS обрабатывает входящие WebSocket соединения
 func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
+	if !s.beginHandler() {
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.active.Done()
+
 	conn, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Printf("Websocket upgrade error: %v", err)
@@ -147,9 +167,13 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 
 	// Создаем потокобезопасную обертку для WebSocket соединения
 	safeConn := NewSafeWriter(conn)
+	s.addConnection(safeConn)
 	defer func() {
-		// Удаляем игрока при закрытии соединения
-		s.removePlayer(safeConn)
+		// Удаляем игрока при закрытии соединения. При остановке сервера игрок
+		// остается в игре, чтобы его показатели попали в последнее сохранение.
+		if !s.removeConnection(safeConn) {
+			s.removePlayer(safeConn)
+		}
 		safeConn.Close()
 	}()
 
@@ -164,16 +188,26 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 	// Отправляем клиенту конфигурацию физики перед созданием объектов
 	s.sendPhysicsConfig(safeConn)
 
+	// Клиенты с ?terrain=chunks получают террейн чанками вместо целой heightmap
+	chunkedTerrain := r.URL.Query().Get("terrain") == "chunks"
+
 	// Теперь отправляем существующие объекты
-	if err := s.serializer.SendCreateForAllObjects(safeConn); err != nil {
-		log.Printf("[Go] Ошибка при отправке существующих объектов: %v", err)
+	var sendErr error
+	if chunkedTerrain {
+		sendErr = s.serializer.SendCreateForAllObjectsChunked(safeConn, s.terrainStreaming)
+	} else {
+		sendErr = s.serializer.SendCreateForAllObjects(safeConn)
+	}
+	if sendErr != nil {
+		log.Printf("[Go] Ошибка при отправке существующих объектов: %v", sendErr)
 		return
 	}
 
 	// Создаем нового игрока через очередь для избежания гонок
 	request := &PlayerCreationRequest{
-		Conn:     safeConn,
-		Response: make(chan *PlayerCreationResponse, 1),
+		Conn:        safeConn,
+		ResumeToken: r.URL.Query().Get("resume_token"),
+		Response:    make(chan *PlayerCreationResponse, 1),
 	}
 
 	// Отправляем запрос в очередь
@@ -228,6 +262,12 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 
 	go s.startClientStreaming(safeConn)
 
+	if chunkedTerrain {
+		if player := s.getPlayerByConnection(safeConn); player != nil {
+			go s.startTerrainStreaming(safeConn, player)
+		}
+	}
+
 	s.handlers[MessageTypeMove] = s.handleCmd
 	// Основной цикл обработки сообщений
 	for {
@@ -281,8 +321,11 @@ func (s *WSServer) HandleWS(w http.ResponseWriter, r *http.Request) {
 
 // Start запускает сервер обновлений
 func (s *WSServer) Start() {
-	// Запускаем горутину для регулярного применения импульсов
-	go s.applyImpulses()
+	// Без очереди ввода силу контроллеров применяет отдельная горутина, с очередью -
+	// игровой цикл
+	if s.inputQueue == nil {
+		go s.applyImpulses()
+	}
 
 	// Запускаем HTTP сервер
 	http.HandleFunc("/ws", s.HandleWS)
@@ -291,11 +334,6 @@ func (s *WSServer) Start() {
 
 // === МЕТОДЫ ДЛЯ РАБОТЫ С СИСТЕМОЙ ЕДЫ ===
 
-// SetFoodSystem устанавливает ссылку на систему еды
-func (s *WSServer) SetFoodSystem(foodSystem interface{}) {
-	s.foodSystem = foodSystem
-}
-
 // SetGameTicker устанавливает ссылку на GameTicker
 func (s *WSServer) SetGameTicker(gameTicker interface{}) {
 	s.gameTicker = gameTicker
@@ -378,3 +416,82 @@ func (s *WSServer) BroadcastPlayerSizeUpdate(playerID string, newRadius float64,
 	log.Printf("[WSServer] Отправлено обновление размера игрока %s: радиус %.2f, масса %.2f",
 		playerID, newRadius, newMass)
 }
+
+// BroadcastObjectDespawned рассылает клиентам удаление объекта из мира
+func (s *WSServer) BroadcastObjectDespawned(event world.DespawnEvent) {
+	message := NewDeleteMessage(event.ObjectID, string(event.Reason))
+
+	s.playersMu.RLock()
+	defer s.playersMu.RUnlock()
+
+	for _, player := range s.players {
+		if err := player.Conn.WriteJSON(message); err != nil {
+			log.Printf("[WSServer] Ошибка отправки удаления объекта %s игроку %s: %v", event.ObjectID, player.ID, err)
+		}
+	}
+}
+
+// BroadcastPlayerOutOfBounds рассылает клиентам событие выхода игрока за границы мира
+func (s *WSServer) BroadcastPlayerOutOfBounds(event world.BoundsEvent) {
+	message := NewOutOfBoundsMessage(event)
+
+	s.playersMu.RLock()
+	defer s.playersMu.RUnlock()
+
+	for _, player := range s.players {
+		if err := player.Conn.WriteJSON(message); err != nil {
+			log.Printf("[WSServer] Ошибка отправки выхода за границы %s игроку %s: %v", event.PlayerID, player.ID, err)
+		}
+	}
+}
+
+// BroadcastPlayerEaten рассылает клиентам поедание игрока. Вместе с событием
+// отправляется удаление объекта жертвы, чтобы клиенты убрали его со сцены.
+func (s *WSServer) BroadcastPlayerEaten(eaterID, victimID string, massGain float64) {
+	deleteMessage := NewDeleteMessage(victimID, "eaten")
+	message := NewPlayerEatenMessage(eaterID, victimID, massGain)
+
+	s.playersMu.RLock()
+	defer s.playersMu.RUnlock()
+
+	for _, player := range s.players {
+		if err := player.Conn.WriteJSON(deleteMessage); err != nil {
+			log.Printf("[WSServer] Ошибка отправки удаления объекта %s игроку %s: %v", victimID, player.ID, err)
+			continue
+		}
+		if err := player.Conn.WriteJSON(message); err != nil {
+			log.Printf("[WSServer] Ошибка отправки поедания игрока %s игроку %s: %v", victimID, player.ID, err)
+		}
+	}
+
+	log.Printf("[WSServer] Отправлено событие поедания: игрок %s съел игрока %s (+%.1f массы)",
+		eaterID, victimID, massGain)
+}
+
+// BroadcastPlayerDied рассылает клиентам гибель игрока
+func (s *WSServer) BroadcastPlayerDied(playerID, killerID string) {
+	message := NewPlayerDiedMessage(playerID, killerID)
+
+	s.playersMu.RLock()
+	defer s.playersMu.RUnlock()
+
+	for _, player := range s.players {
+		if err := player.Conn.WriteJSON(message); err != nil {
+			log.Printf("[WSServer] Ошибка отправки гибели игрока %s игроку %s: %v", playerID, player.ID, err)
+		}
+	}
+}
+
+// BroadcastTerrainPatch рассылает всем клиентам измененный участок террейна
+func (s *WSServer) BroadcastTerrainPatch(patch world.TerrainPatch) {
+	message := NewTerrainPatchMessage(patch)
+
+	s.playersMu.RLock()
+	defer s.playersMu.RUnlock()
+
+	for _, player := range s.players {
+		if err := player.Conn.WriteJSON(message); err != nil {
+			log.Printf("[WSServer] Ошибка отправки изменения террейна %s игроку %s: %v", patch.TerrainID, player.ID, err)
+		}
+	}
+}
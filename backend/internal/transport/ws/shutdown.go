@@ -0,0 +1,80 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gorilla/websocket"
+)
+
+// beginHandler регистрирует обработчик нового подключения.
+// Возвращает false, если сервер уже останавливается.
+func (s *WSServer) beginHandler() bool {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+
+	if s.closing {
+		return false
+	}
+	s.active.Add(1)
+	return true
+}
+
+func (s *WSServer) addConnection(conn *SafeWriter) {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+	s.conns[conn] = struct{}{}
+}
+
+// removeConnection забывает соединение и сообщает, идет ли остановка сервера
+func (s *WSServer) removeConnection(conn *SafeWriter) (closing bool) {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+	delete(s.conns, conn)
+	return s.closing
+}
+
+// Shutdown перестает принимать WebSocket подключения, сообщает клиентам об остановке
+// сервера и закрывает соединения. Ждет завершения обработчиков, но не дольше ctx.
+func (s *WSServer) Shutdown(ctx context.Context) error {
+	s.connsMu.Lock()
+	s.closing = true
+	conns := make([]*SafeWriter, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.connsMu.Unlock()
+
+	log.Printf("[WSServer] Остановка: новые подключения отклоняются, закрываем соединений: %d", len(conns))
+
+	message := NewShutdownMessage("Сервер останавливается")
+	closeFrame := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutdown")
+	for _, conn := range conns {
+		// Запись медленному клиенту может зависнуть, поэтому каждое соединение закрывается отдельно
+		go func(conn *SafeWriter) {
+			if err := conn.WriteJSON(message); err != nil {
+				log.Printf("[WSServer] Ошибка отправки уведомления об остановке: %v", err)
+			}
+			conn.WriteMessage(websocket.CloseMessage, closeFrame)
+			conn.Close()
+		}(conn)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.active.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		// Не успевшие получить уведомление клиенты отключаются принудительно
+		for _, conn := range conns {
+			conn.Close()
+		}
+		return fmt.Errorf("не все WebSocket соединения закрыты: %w", ctx.Err())
+	}
+}
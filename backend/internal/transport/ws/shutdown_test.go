@@ -0,0 +1,84 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWSServer_ShutdownNotifiesClients(t *testing.T) {
+	s := &WSServer{conns: make(map[*SafeWriter]struct{})}
+
+	// Обработчик, который держит соединение открытым, как HandleWS
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.beginHandler() {
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer s.active.Done()
+
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Failed to upgrade connection: %v", err)
+			return
+		}
+		safeConn := NewSafeWriter(conn)
+		s.addConnection(safeConn)
+		defer s.removeConnection(safeConn)
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+	client, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket server: %v", err)
+	}
+	defer client.Close()
+
+	// Ждем, пока сервер зарегистрирует соединение
+	deadline := time.Now().Add(time.Second)
+	for {
+		s.connsMu.Lock()
+		n := len(s.conns)
+		s.connsMu.Unlock()
+		if n == 1 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown error: %v", err)
+	}
+
+	var message ShutdownMessage
+	if err := client.ReadJSON(&message); err != nil {
+		t.Fatalf("Клиент должен получить уведомление об остановке: %v", err)
+	}
+	if message.Type != MessageTypeShutdown {
+		t.Errorf("Expected %s, got %s", MessageTypeShutdown, message.Type)
+	}
+	if _, _, err := client.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+		t.Errorf("Ожидали закрытие с кодом going away, получили %v", err)
+	}
+
+	// Новые подключения отклоняются
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err == nil || resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Новое подключение должно получить 503, получили %v", err)
+	}
+}
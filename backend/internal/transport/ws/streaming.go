@@ -139,7 +139,7 @@ func (s *WSServer) startClientStreaming(wsWriter *SafeWriter) {
 
 // sendPhysicsConfig отправляет конфигурацию физики клиенту
 func (s *WSServer) sendPhysicsConfig(conn *SafeWriter) {
-	physicsConfig := world.GetPhysicsConfig()
+	physicsConfig := s.physicsConfig
 
 	// Создаем плоскую структуру для обратной совместимости с фронтендом
 	flatConfig := map[string]interface{}{
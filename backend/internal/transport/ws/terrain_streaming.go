@@ -0,0 +1,218 @@
+package ws
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	pb "x-cells/backend/internal/physics/generated"
+	"x-cells/backend/internal/world"
+)
+
+// TerrainStreamingConfig настройки потоковой передачи террейна чанками
+type TerrainStreamingConfig struct {
+	ChunkSize          int32         // Размер чанка в ячейках heightmap
+	LODDistances       []float32     // LOD i используется для чанков ближе LODDistances[i]; дальше - самый грубый уровень
+	MaxChunksPerUpdate int           // Максимум чанков за одно обновление (ближайшие отправляются первыми)
+	UpdateInterval     time.Duration // Интервал пересчета нужных чанков
+}
+
+// positionRequestTimeout сколько ждать позицию игрока от физики. Если Bullet не ответил,
+// чанки выбираются по позиции из мира.
+const positionRequestTimeout = 200 * time.Millisecond
+
+// DefaultTerrainStreamingConfig возвращает настройки потоковой передачи по умолчанию
+func DefaultTerrainStreamingConfig() TerrainStreamingConfig {
+	return TerrainStreamingConfig{
+		ChunkSize:          32,
+		LODDistances:       []float32{100, 200, 400}, // 4 уровня: шаг 1, 2, 4 и 8 узлов
+		MaxChunksPerUpdate: 4,
+		UpdateInterval:     250 * time.Millisecond,
+	}
+}
+
+// LODLevels возвращает количество уровней детализации
+func (c TerrainStreamingConfig) LODLevels() int {
+	return len(c.LODDistances) + 1
+}
+
+// desiredLOD возвращает уровень детализации для чанка на заданном расстоянии
+func (c TerrainStreamingConfig) desiredLOD(distance float32) int {
+	for lod, maxDistance := range c.LODDistances {
+		if distance < maxDistance {
+			return lod
+		}
+	}
+	return len(c.LODDistances)
+}
+
+// terrainChunkKey координаты чанка в сетке чанков
+type terrainChunkKey struct {
+	X, Z int32
+}
+
+// terrainChunkRequest чанк, который нужно отправить клиенту
+type terrainChunkRequest struct {
+	Key      terrainChunkKey
+	LOD      int
+	Distance float32
+}
+
+// terrainStream учет отправленных чанков террейна для одного соединения
+type terrainStream struct {
+	terrainID string
+	terrain   *world.TerrainData
+	config    TerrainStreamingConfig
+	sent      map[terrainChunkKey]int // чанк -> самый детальный отправленный LOD
+}
+
+// newTerrainStream создает состояние потоковой передачи для соединения
+func newTerrainStream(terrainID string, terrain *world.TerrainData, config TerrainStreamingConfig) *terrainStream {
+	return &terrainStream{
+		terrainID: terrainID,
+		terrain:   terrain,
+		config:    config,
+		sent:      make(map[terrainChunkKey]int),
+	}
+}
+
+// plan возвращает чанки, которые нужно отправить игроку в точке (x, z):
+// еще не отправленные или отправленные с меньшей детализацией, чем нужно сейчас.
+// Чанки отсортированы по расстоянию, количество ограничено MaxChunksPerUpdate.
+func (ts *terrainStream) plan(x, z float32) []terrainChunkRequest {
+	chunksX, chunksZ := ts.terrain.ChunkGrid(ts.config.ChunkSize)
+
+	var requests []terrainChunkRequest
+	for cz := int32(0); cz < chunksZ; cz++ {
+		for cx := int32(0); cx < chunksX; cx++ {
+			key := terrainChunkKey{X: cx, Z: cz}
+			distance := ts.terrain.ChunkDistance(x, z, cx, cz, ts.config.ChunkSize)
+			lod := ts.config.desiredLOD(distance)
+
+			// Детализацию только повышаем: уже отправленные данные клиент сохраняет
+			if sentLOD, ok := ts.sent[key]; ok && sentLOD <= lod {
+				continue
+			}
+
+			requests = append(requests, terrainChunkRequest{Key: key, LOD: lod, Distance: distance})
+		}
+	}
+
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].Distance != requests[j].Distance {
+			return requests[i].Distance < requests[j].Distance
+		}
+		if requests[i].Key.Z != requests[j].Key.Z {
+			return requests[i].Key.Z < requests[j].Key.Z
+		}
+		return requests[i].Key.X < requests[j].Key.X
+	})
+
+	if ts.config.MaxChunksPerUpdate > 0 && len(requests) > ts.config.MaxChunksPerUpdate {
+		requests = requests[:ts.config.MaxChunksPerUpdate]
+	}
+
+	return requests
+}
+
+// markSent запоминает, что чанк отправлен с указанным уровнем детализации
+func (ts *terrainStream) markSent(key terrainChunkKey, lod int) {
+	ts.sent[key] = lod
+}
+
+// complete возвращает true, когда все чанки отправлены с полной детализацией
+func (ts *terrainStream) complete() bool {
+	chunksX, chunksZ := ts.terrain.ChunkGrid(ts.config.ChunkSize)
+	if len(ts.sent) < int(chunksX*chunksZ) {
+		return false
+	}
+	for _, lod := range ts.sent {
+		if lod != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SetTerrainStreamingConfig устанавливает настройки потоковой передачи террейна
+func (s *WSServer) SetTerrainStreamingConfig(config TerrainStreamingConfig) {
+	s.terrainStreaming = config
+}
+
+// findTerrainObject возвращает объект террейна из мира
+func (s *WSServer) findTerrainObject() *world.WorldObject {
+	for _, obj := range s.objectManager.GetAllWorldObjects() {
+		if obj.Shape != nil && obj.Shape.Type == world.TERRAIN && obj.Shape.Terrain != nil {
+			return obj
+		}
+	}
+	return nil
+}
+
+// playerPositionXZ возвращает текущую позицию объекта игрока по X и Z
+func (s *WSServer) playerPositionXZ(objectID string) (float32, float32, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), positionRequestTimeout)
+	defer cancel()
+
+	resp, err := s.physics.GetObjectState(ctx, &pb.GetObjectStateRequest{
+		Id: objectID,
+	})
+	if err == nil && resp.Status == "OK" && resp.State != nil && resp.State.Position != nil {
+		return resp.State.Position.X, resp.State.Position.Z, true
+	}
+
+	// Если физический движок недоступен или не ответил вовремя, используем позицию из мира
+	obj, exists := s.objectManager.GetObject(objectID)
+	if !exists {
+		return 0, 0, false
+	}
+	return obj.Position.X, obj.Position.Z, true
+}
+
+// startTerrainStreaming отправляет клиенту чанки террейна вокруг его игрока:
+// сначала ближайшие, затем повышает детализацию по мере перемещения игрока
+func (s *WSServer) startTerrainStreaming(conn *SafeWriter, player *PlayerConnection) {
+	terrainObj := s.findTerrainObject()
+	if terrainObj == nil {
+		return
+	}
+
+	config := s.terrainStreaming
+	terrain := terrainObj.Shape.Terrain
+	stream := newTerrainStream(terrainObj.ID, terrain, config)
+
+	ticker := time.NewTicker(config.UpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		// Соединение закрыто - игрок удален
+		if s.getPlayerByConnection(conn) == nil {
+			return
+		}
+
+		if x, z, ok := s.playerPositionXZ(player.ObjectID); ok {
+			for _, request := range stream.plan(x, z) {
+				// Высоты читаются под блокировкой мира: террейн может деформироваться
+				chunk, ok := s.objectManager.TerrainChunk(stream.terrainID, request.Key.X, request.Key.Z, config.ChunkSize, request.LOD)
+				if !ok {
+					continue
+				}
+
+				if err := conn.WriteJSON(NewTerrainChunkMessage(stream.terrainID, chunk)); err != nil {
+					log.Printf("[TerrainStreaming] Ошибка отправки чанка (%d, %d) игроку %s: %v",
+						chunk.ChunkX, chunk.ChunkZ, player.ID, err)
+					return
+				}
+				stream.markSent(request.Key, request.LOD)
+			}
+
+			if stream.complete() {
+				log.Printf("[TerrainStreaming] Игроку %s отправлен весь террейн с полной детализацией", player.ID)
+				return
+			}
+		}
+
+		<-ticker.C
+	}
+}
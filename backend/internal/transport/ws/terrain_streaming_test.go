@@ -0,0 +1,80 @@
+package ws
+
+import (
+	"testing"
+
+	"x-cells/backend/internal/world"
+)
+
+func createStreamingTestTerrain() *world.TerrainData {
+	// 33x33 nodes, 4x4 chunks of 8 cells, 10 units per cell
+	return &world.TerrainData{
+		HeightData: make([]float32, 33*33),
+		Width:      33,
+		Depth:      33,
+		ScaleX:     10,
+		ScaleY:     1,
+		ScaleZ:     10,
+	}
+}
+
+func TestTerrainStream_PlanNearestFirst(t *testing.T) {
+	config := TerrainStreamingConfig{
+		ChunkSize:          8,
+		LODDistances:       []float32{60, 120},
+		MaxChunksPerUpdate: 3,
+	}
+	stream := newTerrainStream("terrain_1", createStreamingTestTerrain(), config)
+
+	// Player stands in the center of chunk (0, 0)
+	requests := stream.plan(-120, -120)
+	if len(requests) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(requests))
+	}
+	if requests[0].Key != (terrainChunkKey{X: 0, Z: 0}) || requests[0].LOD != 0 {
+		t.Errorf("Expected chunk (0, 0) at LOD 0 first, got %+v", requests[0])
+	}
+	for i := 1; i < len(requests); i++ {
+		if requests[i].Distance < requests[i-1].Distance {
+			t.Errorf("Requests are not sorted by distance: %+v", requests)
+		}
+	}
+}
+
+func TestTerrainStream_UpgradesLODWhenPlayerMoves(t *testing.T) {
+	config := TerrainStreamingConfig{
+		ChunkSize:    8,
+		LODDistances: []float32{60, 120},
+	}
+	stream := newTerrainStream("terrain_1", createStreamingTestTerrain(), config)
+
+	// Send everything as seen from the corner of the map
+	for _, request := range stream.plan(-120, -120) {
+		stream.markSent(request.Key, request.LOD)
+	}
+	if len(stream.plan(-120, -120)) != 0 {
+		t.Fatal("Nothing should be resent while the player stays in place")
+	}
+
+	farKey := terrainChunkKey{X: 3, Z: 3}
+	if stream.sent[farKey] != 2 {
+		t.Fatalf("Expected far chunk at LOD 2, got %d", stream.sent[farKey])
+	}
+
+	// Move to the opposite corner: the far chunk must be upgraded to full detail
+	upgraded := false
+	for _, request := range stream.plan(120, 120) {
+		if request.Key == farKey {
+			upgraded = request.LOD == 0
+		}
+		if request.LOD >= stream.sent[request.Key] {
+			t.Errorf("Chunk %+v must not be resent without a detail upgrade", request.Key)
+		}
+	}
+	if !upgraded {
+		t.Error("Expected chunk (3, 3) to be upgraded to LOD 0")
+	}
+	if stream.complete() {
+		t.Error("Stream must not be complete before all chunks reach LOD 0")
+	}
+}
@@ -10,6 +10,17 @@ const (
 	MessageTypeCommand = "cmd"     // Команда от клиента
 	MessageTypeAck     = "cmd_ack" // Подтверждение команды
 	MessageTypeInfo    = "info"    // Информационное сообщение
+	MessageTypeDelete  = "delete"  // Удаление объекта
+
+	MessageTypeOutOfBounds = "out_of_bounds"   // Игрок вышел за границы мира и был перенесен или вытолкнут
+	MessageTypeShutdown    = "server_shutdown" // Сервер останавливается, соединение будет закрыто
+	MessageTypePlayerEaten = "player_eaten"    // Игрок съел другого игрока
+	MessageTypePlayerDied  = "player_died"     // Игрок погиб и удален из мира
+
+	// Потоковая передача террейна по чанкам
+	MessageTypeTerrainInfo  = "terrain_info"  // Метаданные террейна без высот
+	MessageTypeTerrainChunk = "terrain_chunk" // Чанк heightmap с уровнем детализации
+	MessageTypeTerrainPatch = "terrain_patch" // Измененный во время игры участок heightmap
 )
 
 // ObjectMessage представляет сообщение о создании или обновлении объекта
@@ -47,6 +58,7 @@ type CommandMessage struct {
 	Type       string      `json:"type"`
 	Cmd        string      `json:"cmd,omitempty"`
 	ClientTime int64       `json:"client_time,omitempty"`
+	Seq        uint64      `json:"seq,omitempty"` // Порядковый номер команды клиента
 	Data       interface{} `json:"data"`
 	ObjectID   string      `json:"object_id"`
 }
@@ -57,6 +69,8 @@ type AckMessage struct {
 	Cmd        string `json:"cmd"`
 	ClientTime int64  `json:"client_time"`
 	ServerTime int64  `json:"server_time"`
+	Seq        uint64 `json:"seq,omitempty"`  // Номер примененной команды
+	Tick       uint64 `json:"tick,omitempty"` // Тик, в котором команда применена
 }
 
 // PingMessage представляет пинг от клиента
@@ -72,8 +86,103 @@ type PongMessage struct {
 	ServerTime int64  `json:"server_time"`
 }
 
+// DeleteMessage сообщает клиентам об удалении объекта из мира
+type DeleteMessage struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Reason     string `json:"reason,omitempty"`
+	ServerTime int64  `json:"server_time"`
+}
+
+// OutOfBoundsMessage сообщает клиентам, что игрок покинул границы мира
+type OutOfBoundsMessage struct {
+	Type       string  `json:"type"`
+	PlayerID   string  `json:"player_id"`
+	ObjectID   string  `json:"object_id"`
+	Action     string  `json:"action"` // clamp, push или respawn
+	Reason     string  `json:"reason"` // out_of_bounds или kill_plane
+	X          float32 `json:"x"`      // Новая позиция (для push - текущая)
+	Y          float32 `json:"y"`
+	Z          float32 `json:"z"`
+	ServerTime int64   `json:"server_time"`
+}
+
+// PlayerEatenMessage сообщает клиентам, что один игрок съел другого
+type PlayerEatenMessage struct {
+	Type       string  `json:"type"`
+	EaterID    string  `json:"eater_id"`
+	VictimID   string  `json:"victim_id"`
+	MassGain   float64 `json:"mass_gain"`
+	ServerTime int64   `json:"server_time"`
+}
+
+// PlayerDiedMessage сообщает клиентам о гибели игрока
+type PlayerDiedMessage struct {
+	Type       string `json:"type"`
+	PlayerID   string `json:"player_id"`
+	KillerID   string `json:"killer_id,omitempty"` // Пуст, если игрока никто не съел
+	ServerTime int64  `json:"server_time"`
+}
+
+// ShutdownMessage сообщает клиентам об остановке сервера
+type ShutdownMessage struct {
+	Type       string `json:"type"`
+	Reason     string `json:"reason"`
+	ServerTime int64  `json:"server_time"`
+}
+
 // InfoMessage представляет информационное сообщение от сервера
 type InfoMessage struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
 }
+
+// TerrainInfoMessage описывает террейн, высоты которого передаются чанками
+type TerrainInfoMessage struct {
+	Type       string  `json:"type"`
+	ID         string  `json:"id"`
+	X          float32 `json:"x"`
+	Y          float32 `json:"y"`
+	Z          float32 `json:"z"`
+	Color      string  `json:"color,omitempty"`
+	PhysicsBy  string  `json:"physics_by,omitempty"`
+	HeightmapW int32   `json:"heightmap_w"`
+	HeightmapH int32   `json:"heightmap_h"`
+	ScaleX     float32 `json:"scale_x"`
+	ScaleY     float32 `json:"scale_y"`
+	ScaleZ     float32 `json:"scale_z"`
+	MinHeight  float32 `json:"min_height"`
+	MaxHeight  float32 `json:"max_height"`
+	ChunkSize  int32   `json:"chunk_size"`
+	ChunksX    int32   `json:"chunks_x"`
+	ChunksZ    int32   `json:"chunks_z"`
+	LODLevels  int     `json:"lod_levels"`
+	ServerTime int64   `json:"server_time"`
+}
+
+// TerrainChunkMessage содержит высоты одного чанка террейна
+type TerrainChunkMessage struct {
+	Type      string    `json:"type"`
+	TerrainID string    `json:"terrain_id"`
+	ChunkX    int32     `json:"cx"`
+	ChunkZ    int32     `json:"cz"`
+	LOD       int       `json:"lod"`
+	Step      int32     `json:"step"`
+	OffsetX   int32     `json:"offset_x"`
+	OffsetZ   int32     `json:"offset_z"`
+	Width     int32     `json:"width"`
+	Depth     int32     `json:"depth"`
+	Heights   []float32 `json:"heights"`
+}
+
+// TerrainPatchMessage содержит новые высоты прямоугольного участка террейна (полная детализация)
+type TerrainPatchMessage struct {
+	Type       string    `json:"type"`
+	TerrainID  string    `json:"terrain_id"`
+	OffsetX    int32     `json:"offset_x"`
+	OffsetZ    int32     `json:"offset_z"`
+	Width      int32     `json:"width"`
+	Depth      int32     `json:"depth"`
+	Heights    []float32 `json:"heights"`
+	ServerTime int64     `json:"server_time"`
+}
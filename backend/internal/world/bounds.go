@@ -0,0 +1,104 @@
+package world
+
+import "time"
+
+// Отступ границ мира от края террейна по умолчанию
+const DefaultBoundsMargin = float32(2.0)
+
+// Высота плоскости смерти по умолчанию - ниже нее игрок считается упавшим с карты
+const DefaultKillPlaneY = float32(-100.0)
+
+// BoundsAction что делать с игроком, вышедшим за границы мира
+type BoundsAction string
+
+const (
+	BoundsActionClamp   BoundsAction = "clamp"   // Перенести на ближайшую точку внутри границ
+	BoundsActionPush    BoundsAction = "push"    // Толкать импульсом обратно к центру
+	BoundsActionRespawn BoundsAction = "respawn" // Убить и возродить в случайной точке
+)
+
+// BoundsReason почему сработала проверка границ
+type BoundsReason string
+
+const (
+	BoundsReasonOutside   BoundsReason = "out_of_bounds" // Вышел за границы по X/Z
+	BoundsReasonKillPlane BoundsReason = "kill_plane"    // Упал ниже плоскости смерти
+)
+
+// BoundsEvent событие: игрок вышел за границы мира и был обработан
+type BoundsEvent struct {
+	PlayerID string
+	ObjectID string
+	Action   BoundsAction
+	Reason   BoundsReason
+	From     Vector3 // Позиция, в которой игрок был обнаружен
+	To       Vector3 // Новая позиция (для push совпадает с From)
+	Time     time.Time
+}
+
+// WorldBounds границы игровой области по X/Z и высота плоскости смерти
+type WorldBounds struct {
+	MinX   float32 `json:"minX"`
+	MaxX   float32 `json:"maxX"`
+	MinZ   float32 `json:"minZ"`
+	MaxZ   float32 `json:"maxZ"`
+	KillY  float32 `json:"killY"`
+	Margin float32 `json:"margin,omitempty"` // Отступ внутрь при возврате игрока в границы
+}
+
+// BoundsFromTerrain вычисляет границы мира по краям террейна.
+// Плоскость смерти ставится на killY, но не выше минимальной высоты террейна.
+func BoundsFromTerrain(terrain *TerrainData, killY float32) WorldBounds {
+	minX, minZ, maxX, maxZ := terrain.Bounds()
+
+	// Самая низкая точка поверхности в мировых координатах (с той же раскладкой, что и HeightAt)
+	lowest := terrain.Origin.Y + (terrain.Stats().MinHeight-terrain.centerHeight())*terrain.ScaleY
+	if killY > lowest-DefaultBoundsMargin {
+		killY = lowest - DefaultBoundsMargin
+	}
+
+	return WorldBounds{
+		MinX:   minX,
+		MaxX:   maxX,
+		MinZ:   minZ,
+		MaxZ:   maxZ,
+		KillY:  killY,
+		Margin: DefaultBoundsMargin,
+	}
+}
+
+// ContainsXZ возвращает true, если точка внутри границ по X/Z
+func (b WorldBounds) ContainsXZ(x, z float32) bool {
+	return x >= b.MinX && x <= b.MaxX && z >= b.MinZ && z <= b.MaxZ
+}
+
+// BelowKillPlane возвращает true, если точка ниже плоскости смерти
+func (b WorldBounds) BelowKillPlane(y float32) bool {
+	return y < b.KillY
+}
+
+// ClampXZ возвращает ближайшую к (x, z) точку внутри границ с учетом отступа radius + Margin
+func (b WorldBounds) ClampXZ(x, z, radius float32) (float32, float32) {
+	inset := radius + b.Margin
+	return clampInset(x, b.MinX, b.MaxX, inset), clampInset(z, b.MinZ, b.MaxZ, inset)
+}
+
+// Center возвращает центр области по X/Z
+func (b WorldBounds) Center() (float32, float32) {
+	return (b.MinX + b.MaxX) / 2, (b.MinZ + b.MaxZ) / 2
+}
+
+// clampInset ограничивает v отрезком [min+inset, max-inset]; если отрезок слишком узок - центром
+func clampInset(v, min, max, inset float32) float32 {
+	lo, hi := min+inset, max-inset
+	if lo > hi {
+		return (min + max) / 2
+	}
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
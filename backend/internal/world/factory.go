@@ -2,6 +2,7 @@ package world
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	pb "x-cells/backend/internal/physics/generated"
@@ -11,16 +12,26 @@ import (
 type Factory struct {
 	manager       *Manager
 	physicsClient pb.PhysicsClient
+	materials     *MaterialRegistry
+	config        PhysicsConfig
 }
 
-// NewFactory создает новый экземпляр Factory
-func NewFactory(manager *Manager, physicsClient pb.PhysicsClient) *Factory {
+// NewFactory создает новый экземпляр Factory с итоговой конфигурацией физики комнаты
+// (см. PhysicsSettings.Resolve)
+func NewFactory(manager *Manager, physicsClient pb.PhysicsClient, config PhysicsConfig) *Factory {
 	return &Factory{
 		manager:       manager,
 		physicsClient: physicsClient,
+		materials:     NewDefaultMaterialRegistry(),
+		config:        config,
 	}
 }
 
+// PhysicsConfig возвращает конфигурацию физики фабрики
+func (f *Factory) PhysicsConfig() PhysicsConfig {
+	return f.config
+}
+
 // CreateObjectInGameWorld добавляет объект только в игровой мир (без создания в Bullet)
 func (f *Factory) CreateObjectInGameWorld(obj *WorldObject) {
 	// Добавляем объект в менеджер
@@ -34,8 +45,7 @@ func (f *Factory) CreateObjectInGameWorld(obj *WorldObject) {
 func (f *Factory) CreateObjectInBullet(obj *WorldObject) error {
 	ctx := context.Background()
 
-	// Получаем конфигурации
-	physicsConfig := GetPhysicsConfig()
+	physicsConfig := f.config
 
 	// Создаем базовый запрос
 	request := &pb.CreateObjectRequest{
@@ -91,6 +101,7 @@ func (f *Factory) CreateObjectInBullet(obj *WorldObject) error {
 				RollingFriction: obj.Shape.Sphere.RollingFriction,
 				LinearDamping:   obj.Shape.Sphere.LinearDamping,
 				AngularDamping:  obj.Shape.Sphere.AngularDamping,
+				Material:        obj.Shape.Sphere.Material,
 			},
 		}
 	case BOX:
@@ -107,6 +118,7 @@ func (f *Factory) CreateObjectInBullet(obj *WorldObject) error {
 				RollingFriction: obj.Shape.Box.RollingFriction,
 				LinearDamping:   obj.Shape.Box.LinearDamping,
 				AngularDamping:  obj.Shape.Box.AngularDamping,
+				Material:        obj.Shape.Box.Material,
 			},
 		}
 	case TERRAIN:
@@ -121,6 +133,7 @@ func (f *Factory) CreateObjectInBullet(obj *WorldObject) error {
 				ScaleZ:    obj.Shape.Terrain.ScaleZ,
 				MinHeight: obj.MinHeight,
 				MaxHeight: obj.MaxHeight,
+				Material:  obj.Shape.Terrain.Material,
 			},
 		}
 	default:
@@ -166,11 +179,19 @@ func (f *Factory) CreateObjectBullet(obj *WorldObject) error {
 	return err
 }
 
-// NewSphere создает новый сферический объект
+// NewSphere создает новый сферический объект со свойствами из конфигурации по умолчанию
 func NewSphere(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType) *WorldObject {
-	// Получаем глобальные настройки мира и игрока
-	worldConfig := GetWorldConfig()
-	playerConfig := GetPlayerConfig()
+	return newSphere(DefaultPhysicsConfig(), id, position, radius, mass, color, physicsType)
+}
+
+// NewSphere создает сферический объект со свойствами из конфигурации физики фабрики
+func (f *Factory) NewSphere(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType) *WorldObject {
+	return newSphere(f.config, id, position, radius, mass, color, physicsType)
+}
+
+func newSphere(config PhysicsConfig, id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType) *WorldObject {
+	worldConfig := config.World
+	playerConfig := config.Player
 
 	return &WorldObject{
 		Object: &Object{
@@ -184,24 +205,33 @@ func NewSphere(id string, position Vector3, radius, mass float32, color string,
 					Mass:            mass,
 					Color:           color,
 					Restitution:     playerConfig.Restitution,    // из настроек игрока
-					Friction:        worldConfig.Friction,        // из глобальных настроек
-					RollingFriction: worldConfig.RollingFriction, // из глобальных настроек
-					LinearDamping:   worldConfig.LinearDamping,   // из глобальных настроек
-					AngularDamping:  worldConfig.AngularDamping,  // из глобальных настроек
+					Friction:        worldConfig.Friction,        // из настроек мира
+					RollingFriction: worldConfig.RollingFriction, // из настроек мира
+					LinearDamping:   worldConfig.LinearDamping,   // из настроек мира
+					AngularDamping:  worldConfig.AngularDamping,  // из настроек мира
 				},
 			},
 		},
 		PhysicsType: physicsType,
+		Kind:        KindProp,
 		Mass:        mass,
 		Color:       color,
 	}
 }
 
-// NewBox создает новый коробчатый объект
+// NewBox создает новый коробчатый объект со свойствами из конфигурации по умолчанию
 func NewBox(id string, position Vector3, width, height, depth, mass float32, color string, physicsType PhysicsType) *WorldObject {
-	// Получаем глобальные настройки мира и игрока
-	worldConfig := GetWorldConfig()
-	playerConfig := GetPlayerConfig()
+	return newBox(DefaultPhysicsConfig(), id, position, width, height, depth, mass, color, physicsType)
+}
+
+// NewBox создает коробчатый объект со свойствами из конфигурации физики фабрики
+func (f *Factory) NewBox(id string, position Vector3, width, height, depth, mass float32, color string, physicsType PhysicsType) *WorldObject {
+	return newBox(f.config, id, position, width, height, depth, mass, color, physicsType)
+}
+
+func newBox(config PhysicsConfig, id string, position Vector3, width, height, depth, mass float32, color string, physicsType PhysicsType) *WorldObject {
+	worldConfig := config.World
+	playerConfig := config.Player
 
 	return &WorldObject{
 		Object: &Object{
@@ -217,14 +247,15 @@ func NewBox(id string, position Vector3, width, height, depth, mass float32, col
 					Mass:            mass,
 					Color:           color,
 					Restitution:     playerConfig.Restitution,    // из настроек игрока
-					Friction:        worldConfig.Friction,        // из глобальных настроек
-					RollingFriction: worldConfig.RollingFriction, // из глобальных настроек
-					LinearDamping:   worldConfig.LinearDamping,   // из глобальных настроек
-					AngularDamping:  worldConfig.AngularDamping,  // из глобальных настроек
+					Friction:        worldConfig.Friction,        // из настроек мира
+					RollingFriction: worldConfig.RollingFriction, // из настроек мира
+					LinearDamping:   worldConfig.LinearDamping,   // из настроек мира
+					AngularDamping:  worldConfig.AngularDamping,  // из настроек мира
 				},
 			},
 		},
 		PhysicsType: physicsType,
+		Kind:        KindProp,
 		Mass:        mass,
 		Color:       color,
 	}
@@ -248,10 +279,14 @@ func NewTerrain(id string, position Vector3, heightData []float32, width, depth
 					ScaleX:     scaleX,
 					ScaleY:     scaleY,
 					ScaleZ:     scaleZ,
+					Origin:     position,
+					MinHeight:  minHeight,
+					MaxHeight:  maxHeight,
 				},
 			},
 		},
 		PhysicsType: PhysicsTypeBullet,
+		Kind:        KindTerrain,
 		MinHeight:   minHeight,
 		MaxHeight:   maxHeight,
 		Color:       "#007700",
@@ -282,33 +317,83 @@ func NewSphereWithPhysics(id string, position Vector3, radius, mass float32, col
 			},
 		},
 		PhysicsType: physicsType,
+		Kind:        KindProp,
 		Mass:        mass,
 		Color:       color,
 	}
 }
 
-// NewBouncySphere создает прыгучую сферу с высоким restitution
+// NewSphereWithMaterial создает сферу со свойствами из материала
+func NewSphereWithMaterial(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType,
+	material Material) *WorldObject {
+
+	obj := NewSphereWithPhysics(id, position, radius, mass, color, physicsType,
+		material.Restitution, material.Friction, material.RollingFriction, material.LinearDamping, material.AngularDamping)
+	obj.Shape.Sphere.Material = material.Name
+	return obj
+}
+
+// NewBoxWithMaterial создает коробку со свойствами из материала
+func NewBoxWithMaterial(id string, position Vector3, width, height, depth, mass float32, color string, physicsType PhysicsType,
+	material Material) *WorldObject {
+
+	obj := NewBox(id, position, width, height, depth, mass, color, physicsType)
+	applyMaterial(obj, material)
+	return obj
+}
+
+// applyMaterial переносит свойства материала на сферу или коробку
+func applyMaterial(obj *WorldObject, material Material) {
+	switch obj.Shape.Type {
+	case SPHERE:
+		sphere := obj.Shape.Sphere
+		sphere.Restitution = material.Restitution
+		sphere.Friction = material.Friction
+		sphere.RollingFriction = material.RollingFriction
+		sphere.LinearDamping = material.LinearDamping
+		sphere.AngularDamping = material.AngularDamping
+		sphere.Material = material.Name
+	case BOX:
+		box := obj.Shape.Box
+		box.Restitution = material.Restitution
+		box.Friction = material.Friction
+		box.RollingFriction = material.RollingFriction
+		box.LinearDamping = material.LinearDamping
+		box.AngularDamping = material.AngularDamping
+		box.Material = material.Name
+	}
+}
+
+// NewBouncySphere создает прыгучую сферу из резины (высокий отскок, низкое трение)
 func NewBouncySphere(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType) *WorldObject {
-	return NewSphereWithPhysics(id, position, radius, mass, color, physicsType,
-		0.8, 0.5, 0.1, 0.1, 0.2) // высокий отскок, низкое трение
+	rubber, _ := BuiltinMaterial("rubber")
+	return NewSphereWithMaterial(id, position, radius, mass, color, physicsType, rubber)
 }
 
-// NewDeadSphere создает "мертвую" сферу без отскока
+// NewDeadSphere создает "мертвую" сферу из грязи (без отскока, высокое трение и затухание)
 func NewDeadSphere(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType) *WorldObject {
-	return NewSphereWithPhysics(id, position, radius, mass, color, physicsType,
-		0.0, 1.0, 0.3, 0.3, 0.4) // без отскока, высокое трение и затухание
+	mud, _ := BuiltinMaterial("mud")
+	return NewSphereWithMaterial(id, position, radius, mass, color, physicsType, mud)
 }
 
-// NewSlippySphere создает скользкую сферу с низким трением
+// NewSlippySphere создает скользкую ледяную сферу (слабый отскок, очень низкое трение)
 func NewSlippySphere(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType) *WorldObject {
-	return NewSphereWithPhysics(id, position, radius, mass, color, physicsType,
-		0.2, 0.1, 0.05, 0.1, 0.1) // слабый отскок, очень низкое трение
+	ice, _ := BuiltinMaterial("ice")
+	return NewSphereWithMaterial(id, position, radius, mass, color, physicsType, ice)
 }
 
 // NewPlayerWithBounceSkill создает игрока с определенным уровнем прыгучести как скилл
 func NewPlayerWithBounceSkill(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType, bounceSkill float32) *WorldObject {
-	// Получаем глобальные настройки мира
-	worldConfig := GetWorldConfig()
+	return newPlayerWithBounceSkill(DefaultPhysicsConfig(), id, position, radius, mass, color, physicsType, bounceSkill)
+}
+
+// NewPlayerWithBounceSkill создает игрока со свойствами мира из конфигурации физики фабрики
+func (f *Factory) NewPlayerWithBounceSkill(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType, bounceSkill float32) *WorldObject {
+	return newPlayerWithBounceSkill(f.config, id, position, radius, mass, color, physicsType, bounceSkill)
+}
+
+func newPlayerWithBounceSkill(config PhysicsConfig, id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType, bounceSkill float32) *WorldObject {
+	worldConfig := config.World
 
 	return &WorldObject{
 		Object: &Object{
@@ -322,14 +407,16 @@ func NewPlayerWithBounceSkill(id string, position Vector3, radius, mass float32,
 					Mass:            mass,
 					Color:           color,
 					Restitution:     bounceSkill,                 // индивидуальный скилл прыгучести
-					Friction:        worldConfig.Friction,        // из глобальных настроек
-					RollingFriction: worldConfig.RollingFriction, // из глобальных настроек
-					LinearDamping:   worldConfig.LinearDamping,   // из глобальных настроек
-					AngularDamping:  worldConfig.AngularDamping,  // из глобальных настроек
+					Friction:        worldConfig.Friction,        // из настроек мира
+					RollingFriction: worldConfig.RollingFriction, // из настроек мира
+					LinearDamping:   worldConfig.LinearDamping,   // из настроек мира
+					AngularDamping:  worldConfig.AngularDamping,  // из настроек мира
 				},
 			},
 		},
 		PhysicsType: physicsType,
+		Kind:        KindPlayer,
+		OwnerID:     id, // Игрок владеет своим шаром
 		Mass:        mass,
 		Color:       color,
 	}
@@ -374,9 +461,7 @@ func (f *Factory) UpdateObjectRadiusInBullet(objectID string, newRadius float32)
 }
 
 // UpdateObjectMassAndRadiusInBullet обновляет массу и радиус объекта одновременно в Bullet Physics
-func (f *Factory) UpdateObjectMassAndRadiusInBullet(objectID string, newMass, newRadius float32) error {
-	ctx := context.Background()
-
+func (f *Factory) UpdateObjectMassAndRadiusInBullet(ctx context.Context, objectID string, newMass, newRadius float32) error {
 	request := &pb.UpdateObjectMassAndRadiusRequest{
 		Id:     objectID,
 		Mass:   newMass,
@@ -392,3 +477,126 @@ func (f *Factory) UpdateObjectMassAndRadiusInBullet(objectID string, newMass, ne
 	log.Printf("[World] Масса и радиус объекта %s обновлены в Bullet Physics. Статус: %s", objectID, resp.Status)
 	return nil
 }
+
+// RemoveObjectFromBullet удаляет объект из Bullet Physics. Время ожидания ответа
+// ограничивает вызывающий через ctx
+func (f *Factory) RemoveObjectFromBullet(ctx context.Context, objectID string) error {
+	resp, err := f.physicsClient.RemoveObject(ctx, &pb.RemoveObjectRequest{Id: objectID})
+	if err != nil {
+		log.Printf("[World] Ошибка при удалении объекта %s из Bullet: %v", objectID, err)
+		return err
+	}
+
+	log.Printf("[World] Объект %s удален из Bullet Physics. Статус: %s", objectID, resp.Status)
+	return nil
+}
+
+// UpdateTerrainInBullet передает в Bullet Physics измененный участок heightmap террейна
+func (f *Factory) UpdateTerrainInBullet(ctx context.Context, patch TerrainPatch) error {
+	resp, err := f.physicsClient.UpdateTerrainRegion(ctx, &pb.UpdateTerrainRegionRequest{
+		Id:      patch.TerrainID,
+		OffsetX: patch.OffsetX,
+		OffsetZ: patch.OffsetZ,
+		Width:   patch.Width,
+		Depth:   patch.Depth,
+		Heights: patch.Heights,
+	})
+	if err != nil {
+		log.Printf("[World] Ошибка при обновлении террейна %s в Bullet: %v", patch.TerrainID, err)
+		return err
+	}
+	if resp.Status != "OK" {
+		return fmt.Errorf("bullet отклонил обновление террейна %s: %s", patch.TerrainID, resp.Status)
+	}
+	return nil
+}
+
+// RemoveObject удаляет объект из игрового мира и, если его физика на сервере, из Bullet
+func (f *Factory) RemoveObject(ctx context.Context, obj *WorldObject) error {
+	f.manager.RemoveObject(obj.ID)
+
+	if obj.PhysicsType == PhysicsTypeAmmo {
+		return nil
+	}
+	return f.RemoveObjectFromBullet(ctx, obj.ID)
+}
+
+// SetMaterialRegistry устанавливает библиотеку материалов фабрики
+func (f *Factory) SetMaterialRegistry(materials *MaterialRegistry) {
+	f.materials = materials
+}
+
+// Materials возвращает библиотеку материалов фабрики
+func (f *Factory) Materials() *MaterialRegistry {
+	return f.materials
+}
+
+// NewMaterialSphere создает сферу из материала библиотеки по имени
+func (f *Factory) NewMaterialSphere(id string, position Vector3, radius, mass float32, color string, physicsType PhysicsType,
+	materialName string) (*WorldObject, error) {
+
+	material, ok := f.materials.Get(materialName)
+	if !ok {
+		return nil, fmt.Errorf("неизвестный материал %q", materialName)
+	}
+	return NewSphereWithMaterial(id, position, radius, mass, color, physicsType, material), nil
+}
+
+// NewMaterialBox создает коробку из материала библиотеки по имени
+func (f *Factory) NewMaterialBox(id string, position Vector3, width, height, depth, mass float32, color string,
+	physicsType PhysicsType, materialName string) (*WorldObject, error) {
+
+	material, ok := f.materials.Get(materialName)
+	if !ok {
+		return nil, fmt.Errorf("неизвестный материал %q", materialName)
+	}
+	return NewBoxWithMaterial(id, position, width, height, depth, mass, color, physicsType, material), nil
+}
+
+// SyncMaterialsToBullet отправляет библиотеку материалов и правила контакта в Bullet Physics
+func (f *Factory) SyncMaterialsToBullet() error {
+	ctx := context.Background()
+
+	frictionCombine, restitutionCombine := f.materials.CombineModes()
+	request := &pb.SetMaterialsRequest{
+		FrictionCombine:    string(frictionCombine),
+		RestitutionCombine: string(restitutionCombine),
+	}
+
+	for _, material := range f.materials.Materials() {
+		request.Materials = append(request.Materials, &pb.Material{
+			Name:            material.Name,
+			Restitution:     material.Restitution,
+			Friction:        material.Friction,
+			RollingFriction: material.RollingFriction,
+		})
+	}
+
+	for _, rule := range f.materials.PairRules() {
+		pair := &pb.MaterialPairRule{
+			A:                  rule.A,
+			B:                  rule.B,
+			FrictionCombine:    string(rule.FrictionCombine),
+			RestitutionCombine: string(rule.RestitutionCombine),
+		}
+		if rule.Friction != nil {
+			pair.HasFriction = true
+			pair.Friction = *rule.Friction
+		}
+		if rule.Restitution != nil {
+			pair.HasRestitution = true
+			pair.Restitution = *rule.Restitution
+		}
+		request.Pairs = append(request.Pairs, pair)
+	}
+
+	resp, err := f.physicsClient.SetMaterials(ctx, request)
+	if err != nil {
+		log.Printf("[World] Ошибка при отправке материалов в Bullet: %v", err)
+		return err
+	}
+
+	log.Printf("[World] Материалы отправлены в Bullet Physics (%d материалов, %d правил). Статус: %s",
+		len(request.Materials), len(request.Pairs), resp.Status)
+	return nil
+}
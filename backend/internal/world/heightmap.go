@@ -0,0 +1,211 @@
+package world
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// HeightmapFormat формат файла heightmap
+type HeightmapFormat string
+
+const (
+	HeightmapFormatPNG   HeightmapFormat = "png"   // 8/16-битный PNG в оттенках серого
+	HeightmapFormatRaw16 HeightmapFormat = "raw16" // Сырые 16-битные значения little-endian
+)
+
+// HeightmapOptions параметры импорта heightmap
+type HeightmapOptions struct {
+	Format HeightmapFormat // Формат файла; пустое значение - определить по расширению
+
+	// Размеры сетки для raw-файлов (0 - квадратная сетка, размер по длине файла)
+	Width int32
+	Depth int32
+
+	// Диапазон высот: черный (0) -> MinHeight, белый (максимум) -> MaxHeight
+	MinHeight float32
+	MaxHeight float32
+
+	// Масштаб террейна (0 - масштаб 1.0)
+	ScaleX float32
+	ScaleY float32
+	ScaleZ float32
+}
+
+// HeightmapStats сводная информация о heightmap
+type HeightmapStats struct {
+	Width     int32
+	Depth     int32
+	MinHeight float32
+	MaxHeight float32
+	Mean      float32
+}
+
+// LoadHeightmap загружает TerrainData из файла heightmap
+func LoadHeightmap(path string, opts HeightmapOptions) (*TerrainData, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть heightmap %s: %w", path, err)
+	}
+	defer file.Close()
+
+	format := opts.Format
+	if format == "" {
+		format = detectHeightmapFormat(path)
+	}
+
+	switch format {
+	case HeightmapFormatPNG:
+		return DecodeHeightmapPNG(file, opts)
+	case HeightmapFormatRaw16:
+		return DecodeHeightmapRaw16(file, opts)
+	default:
+		return nil, fmt.Errorf("неизвестный формат heightmap: %q", format)
+	}
+}
+
+// DecodeHeightmapPNG строит TerrainData из PNG в оттенках серого (8 или 16 бит).
+// Цветные изображения переводятся в оттенки серого.
+func DecodeHeightmapPNG(r io.Reader, opts HeightmapOptions) (*TerrainData, error) {
+	img, err := png.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка декодирования PNG: %w", err)
+	}
+
+	bounds := img.Bounds()
+	width := int32(bounds.Dx())
+	depth := int32(bounds.Dy())
+	if err := validateHeightmapSize(width, depth); err != nil {
+		return nil, err
+	}
+
+	values := make([]float32, 0, width*depth)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			var normalized float32
+			switch c := img.At(x, y).(type) {
+			case color.Gray:
+				normalized = float32(c.Y) / math.MaxUint8
+			case color.Gray16:
+				normalized = float32(c.Y) / math.MaxUint16
+			default:
+				gray := color.Gray16Model.Convert(c).(color.Gray16)
+				normalized = float32(gray.Y) / math.MaxUint16
+			}
+			values = append(values, normalized)
+		}
+	}
+
+	return newTerrainDataFromNormalized(values, width, depth, opts)
+}
+
+// DecodeHeightmapRaw16 строит TerrainData из сырых 16-битных значений (little-endian)
+func DecodeHeightmapRaw16(r io.Reader, opts HeightmapOptions) (*TerrainData, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения raw heightmap: %w", err)
+	}
+	if len(data)%2 != 0 {
+		return nil, fmt.Errorf("размер raw heightmap (%d байт) не кратен 2", len(data))
+	}
+
+	samples := int32(len(data) / 2)
+	width, depth := opts.Width, opts.Depth
+	if width == 0 && depth == 0 {
+		// Квадратная сетка по умолчанию
+		side := int32(math.Sqrt(float64(samples)))
+		if side*side != samples {
+			return nil, fmt.Errorf("raw heightmap из %d значений не квадратная, укажите ширину и глубину", samples)
+		}
+		width, depth = side, side
+	}
+	if err := validateHeightmapSize(width, depth); err != nil {
+		return nil, err
+	}
+	if width*depth != samples {
+		return nil, fmt.Errorf("размер raw heightmap %dx%d не совпадает с количеством значений %d", width, depth, samples)
+	}
+
+	values := make([]float32, samples)
+	for i := range values {
+		values[i] = float32(binary.LittleEndian.Uint16(data[i*2:])) / math.MaxUint16
+	}
+
+	return newTerrainDataFromNormalized(values, width, depth, opts)
+}
+
+// Stats возвращает размеры и диапазон высот heightmap
+func (t *TerrainData) Stats() HeightmapStats {
+	stats := HeightmapStats{Width: t.Width, Depth: t.Depth}
+	if len(t.HeightData) == 0 {
+		return stats
+	}
+
+	stats.MinHeight = t.HeightData[0]
+	stats.MaxHeight = t.HeightData[0]
+	var sum float64
+	for _, h := range t.HeightData {
+		stats.MinHeight = float32(math.Min(float64(stats.MinHeight), float64(h)))
+		stats.MaxHeight = float32(math.Max(float64(stats.MaxHeight), float64(h)))
+		sum += float64(h)
+	}
+	stats.Mean = float32(sum / float64(len(t.HeightData)))
+
+	return stats
+}
+
+// newTerrainDataFromNormalized переводит значения [0..1] в высоты и собирает TerrainData
+func newTerrainDataFromNormalized(values []float32, width, depth int32, opts HeightmapOptions) (*TerrainData, error) {
+	if opts.MaxHeight < opts.MinHeight {
+		return nil, fmt.Errorf("максимальная высота %.2f меньше минимальной %.2f", opts.MaxHeight, opts.MinHeight)
+	}
+
+	heightRange := opts.MaxHeight - opts.MinHeight
+	heights := make([]float32, len(values))
+	for i, v := range values {
+		heights[i] = opts.MinHeight + v*heightRange
+	}
+
+	return &TerrainData{
+		HeightData: heights,
+		Width:      width,
+		Depth:      depth,
+		ScaleX:     scaleOrDefault(opts.ScaleX),
+		ScaleY:     scaleOrDefault(opts.ScaleY),
+		ScaleZ:     scaleOrDefault(opts.ScaleZ),
+		MinHeight:  opts.MinHeight,
+		MaxHeight:  opts.MaxHeight,
+	}, nil
+}
+
+// detectHeightmapFormat определяет формат heightmap по расширению файла
+func detectHeightmapFormat(path string) HeightmapFormat {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png":
+		return HeightmapFormatPNG
+	case ".raw", ".r16":
+		return HeightmapFormatRaw16
+	default:
+		return ""
+	}
+}
+
+func validateHeightmapSize(width, depth int32) error {
+	if width < 2 || depth < 2 {
+		return fmt.Errorf("heightmap должна быть не меньше 2x2, получено %dx%d", width, depth)
+	}
+	return nil
+}
+
+func scaleOrDefault(scale float32) float32 {
+	if scale == 0 {
+		return 1.0
+	}
+	return scale
+}
@@ -0,0 +1,143 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecodeHeightmapPNG_Gray16(t *testing.T) {
+	img := image.NewGray16(image.Rect(0, 0, 3, 2))
+	img.SetGray16(0, 0, color.Gray16{Y: 0})
+	img.SetGray16(1, 0, color.Gray16{Y: math.MaxUint16 / 2})
+	img.SetGray16(2, 0, color.Gray16{Y: math.MaxUint16})
+	img.SetGray16(0, 1, color.Gray16{Y: math.MaxUint16})
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("Ошибка кодирования PNG: %v", err)
+	}
+
+	terrain, err := DecodeHeightmapPNG(&buf, HeightmapOptions{MinHeight: -10, MaxHeight: 30, ScaleX: 2})
+	if err != nil {
+		t.Fatalf("Ошибка загрузки heightmap: %v", err)
+	}
+
+	if terrain.Width != 3 || terrain.Depth != 2 {
+		t.Fatalf("Ожидали размер 3x2, получили %dx%d", terrain.Width, terrain.Depth)
+	}
+	if terrain.ScaleX != 2 || terrain.ScaleY != 1 || terrain.ScaleZ != 1 {
+		t.Errorf("Неверный масштаб: %.2f x %.2f x %.2f", terrain.ScaleX, terrain.ScaleY, terrain.ScaleZ)
+	}
+
+	expected := []float32{-10, 10, 30, 30, -10, -10}
+	for i, h := range expected {
+		if math.Abs(float64(terrain.HeightData[i]-h)) > 1e-3 {
+			t.Errorf("Высота %d: ожидали %.2f, получили %.2f", i, h, terrain.HeightData[i])
+		}
+	}
+}
+
+func TestDecodeHeightmapPNG_Gray8(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2, 2))
+	img.SetGray(1, 1, color.Gray{Y: math.MaxUint8})
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("Ошибка кодирования PNG: %v", err)
+	}
+
+	terrain, err := DecodeHeightmapPNG(&buf, HeightmapOptions{MaxHeight: 100})
+	if err != nil {
+		t.Fatalf("Ошибка загрузки heightmap: %v", err)
+	}
+
+	stats := terrain.Stats()
+	if stats.MinHeight != 0 || stats.MaxHeight != 100 || !almostEqual(stats.Mean, 25) {
+		t.Errorf("Неверная статистика: %+v", stats)
+	}
+}
+
+func TestDecodeHeightmapRaw16(t *testing.T) {
+	values := []uint16{0, 1000, 2000, 3000, 4000, 5000, 6000, 7000, math.MaxUint16}
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, values)
+
+	// Размер не указан - квадратная сетка 3x3
+	terrain, err := DecodeHeightmapRaw16(bytes.NewReader(buf.Bytes()), HeightmapOptions{MaxHeight: math.MaxUint16})
+	if err != nil {
+		t.Fatalf("Ошибка загрузки heightmap: %v", err)
+	}
+	if terrain.Width != 3 || terrain.Depth != 3 {
+		t.Fatalf("Ожидали размер 3x3, получили %dx%d", terrain.Width, terrain.Depth)
+	}
+	for i, v := range values {
+		if math.Abs(float64(terrain.HeightData[i]-float32(v))) > 1e-2 {
+			t.Errorf("Высота %d: ожидали %d, получили %.2f", i, v, terrain.HeightData[i])
+		}
+	}
+
+	// Несовпадающий размер - ошибка
+	if _, err := DecodeHeightmapRaw16(bytes.NewReader(buf.Bytes()), HeightmapOptions{Width: 4, Depth: 2}); err == nil {
+		t.Error("Ожидали ошибку при несовпадении размера")
+	}
+
+	// Минимальная высота больше максимальной - ошибка
+	if _, err := DecodeHeightmapRaw16(bytes.NewReader(buf.Bytes()), HeightmapOptions{MinHeight: 10}); err == nil {
+		t.Error("Ожидали ошибку при неверном диапазоне высот")
+	}
+}
+
+func TestLevel_LoadTerrain(t *testing.T) {
+	dir := t.TempDir()
+
+	var raw bytes.Buffer
+	binary.Write(&raw, binary.LittleEndian, []uint16{0, math.MaxUint16, math.MaxUint16, 0, 0, 0})
+	if err := os.WriteFile(filepath.Join(dir, "map.r16"), raw.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	levelJSON := `{
+		"name": "test",
+		"terrain": {
+			"heightmap": "map.r16",
+			"width": 3,
+			"depth": 2,
+			"minHeight": 0,
+			"maxHeight": 20,
+			"scaleX": 4,
+			"position": {"x": 10, "y": 0, "z": 0}
+		}
+	}`
+	levelPath := filepath.Join(dir, "level.json")
+	if err := os.WriteFile(levelPath, []byte(levelJSON), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	level, err := LoadLevel(levelPath)
+	if err != nil {
+		t.Fatalf("Ошибка загрузки уровня: %v", err)
+	}
+
+	obj, err := level.LoadTerrain()
+	if err != nil {
+		t.Fatalf("Ошибка загрузки террейна: %v", err)
+	}
+
+	terrain := obj.Shape.Terrain
+	if terrain.Width != 3 || terrain.Depth != 2 || terrain.ScaleX != 4 {
+		t.Errorf("Неверные параметры террейна: %dx%d, масштаб %.2f", terrain.Width, terrain.Depth, terrain.ScaleX)
+	}
+	if obj.Position.X != 10 || terrain.Origin.X != 10 {
+		t.Errorf("Позиция террейна должна быть взята из уровня, получили %+v", obj.Position)
+	}
+	if terrain.HeightData[1] != 20 {
+		t.Errorf("Ожидали высоту 20, получили %.2f", terrain.HeightData[1])
+	}
+}
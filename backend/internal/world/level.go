@@ -0,0 +1,142 @@
+package world
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Level описание уровня, загружаемое из JSON-файла
+type Level struct {
+	Name    string         `json:"name"`
+	Terrain *LevelTerrain  `json:"terrain,omitempty"`
+	Scatter *ScatterConfig `json:"scatter,omitempty"` // Расстановка объектов на террейне уровня
+	Bounds  *WorldBounds   `json:"bounds,omitempty"`  // Границы мира (по умолчанию - края террейна)
+
+	dir string // Директория файла уровня, относительно нее ищутся ресурсы
+}
+
+// LevelTerrain описание террейна уровня
+type LevelTerrain struct {
+	ID        string          `json:"id,omitempty"`
+	Heightmap string          `json:"heightmap"`        // Путь к heightmap (относительно файла уровня)
+	Format    HeightmapFormat `json:"format,omitempty"` // Пустое значение - определить по расширению
+	Width     int32           `json:"width,omitempty"`  // Только для raw-файлов
+	Depth     int32           `json:"depth,omitempty"`  // Только для raw-файлов
+	MinHeight float32         `json:"minHeight"`
+	MaxHeight float32         `json:"maxHeight"`
+	ScaleX    float32         `json:"scaleX,omitempty"`
+	ScaleY    float32         `json:"scaleY,omitempty"`
+	ScaleZ    float32         `json:"scaleZ,omitempty"`
+	Position  Vector3         `json:"position"`
+	Material  string          `json:"material,omitempty"` // Имя материала из библиотеки материалов
+}
+
+// LoadLevel загружает описание уровня из JSON-файла
+func LoadLevel(path string) (*Level, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать уровень %s: %w", path, err)
+	}
+
+	var level Level
+	if err := json.Unmarshal(data, &level); err != nil {
+		return nil, fmt.Errorf("ошибка разбора уровня %s: %w", path, err)
+	}
+	level.dir = filepath.Dir(path)
+
+	return &level, nil
+}
+
+// HeightmapOptions возвращает параметры импорта heightmap террейна
+func (lt *LevelTerrain) HeightmapOptions() HeightmapOptions {
+	return HeightmapOptions{
+		Format:    lt.Format,
+		Width:     lt.Width,
+		Depth:     lt.Depth,
+		MinHeight: lt.MinHeight,
+		MaxHeight: lt.MaxHeight,
+		ScaleX:    lt.ScaleX,
+		ScaleY:    lt.ScaleY,
+		ScaleZ:    lt.ScaleZ,
+	}
+}
+
+// LoadTerrain загружает heightmap уровня и создает объект террейна.
+// Возвращает nil, если террейн в уровне не задан.
+func (l *Level) LoadTerrain() (*WorldObject, error) {
+	if l.Terrain == nil {
+		return nil, nil
+	}
+	if l.Terrain.Heightmap == "" {
+		return nil, fmt.Errorf("в уровне %q не указан файл heightmap", l.Name)
+	}
+
+	path := l.Terrain.Heightmap
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(l.dir, path)
+	}
+
+	data, err := LoadHeightmap(path, l.Terrain.HeightmapOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	id := l.Terrain.ID
+	if id == "" {
+		id = "terrain_1"
+	}
+
+	terrain := NewTerrain(
+		id,
+		l.Terrain.Position,
+		data.HeightData,
+		data.Width,
+		data.Depth,
+		data.ScaleX,
+		data.ScaleY,
+		data.ScaleZ,
+		data.MinHeight,
+		data.MaxHeight,
+	)
+	terrain.PhysicsType = PhysicsTypeBoth
+	terrain.Shape.Terrain.Material = l.Terrain.Material
+
+	return terrain, nil
+}
+
+// Build создает объекты уровня в игровом мире и в физических движках
+func (l *Level) Build(factory *Factory) error {
+	terrain, err := l.LoadTerrain()
+	if err != nil {
+		return err
+	}
+
+	if terrain != nil {
+		// Создаем объект в клиентской физике (Ammo)
+		if err := factory.CreateObjectInAmmo(terrain); err != nil {
+			log.Printf("[World] Ошибка при создании террейна в Ammo: %v", err)
+		}
+
+		// Создаем объект в серверной физике (Bullet)
+		if err := factory.CreateObjectBullet(terrain); err != nil {
+			log.Printf("[World] Ошибка при создании террейна в Bullet: %v", err)
+		}
+
+		stats := terrain.Shape.Terrain.Stats()
+		log.Printf("[World] Уровень %q: террейн %dx%d, высоты [%.2f, %.2f]",
+			l.Name, stats.Width, stats.Depth, stats.MinHeight, stats.MaxHeight)
+
+		if l.Scatter != nil {
+			if _, err := factory.Scatter(terrain.Shape.Terrain, *l.Scatter); err != nil {
+				return fmt.Errorf("ошибка расстановки объектов уровня %q: %w", l.Name, err)
+			}
+		}
+	} else if l.Scatter != nil {
+		log.Printf("[World] Уровень %q: расстановка объектов пропущена - нет террейна", l.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package world
+
+import "time"
+
+// DespawnReason причина удаления объекта из мира
+type DespawnReason string
+
+const (
+	DespawnReasonExpired DespawnReason = "expired" // Истекло время жизни (TTL)
+	DespawnReasonKilled  DespawnReason = "killed"  // Игрок погиб (например, упал ниже плоскости смерти)
+)
+
+// DespawnEvent событие жизненного цикла: объект удален из мира
+type DespawnEvent struct {
+	ObjectID string
+	Object   *WorldObject
+	Reason   DespawnReason
+	Time     time.Time
+}
+
+// WithTTL задает время жизни объекта, отсчитывая его от момента now. now берется
+// из тех же часов, по которым DespawnSystem проверяет истечение (Now() системы),
+// иначе TTL истечет не вовремя. Возвращает сам объект, чтобы TTL можно было указать
+// прямо при создании:
+//
+//	projectile := world.NewSphere(...).WithTTL(s.Now(), 5*time.Second)
+func (o *WorldObject) WithTTL(now time.Time, ttl time.Duration) *WorldObject {
+	o.ExpiresAt = now.Add(ttl)
+	return o
+}
+
+// Expired возвращает true, если у объекта задан TTL и он истек к моменту now
+func (o *WorldObject) Expired(now time.Time) bool {
+	return !o.ExpiresAt.IsZero() && !now.Before(o.ExpiresAt)
+}
+
+// SetObjectTTL задает время жизни существующего объекта, отсчитывая его от момента now
+// по игровым часам. Возвращает false, если объект не найден.
+func (m *Manager) SetObjectTTL(id string, now time.Time, ttl time.Duration) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	obj, exists := m.worldObjects[id]
+	if !exists {
+		return false
+	}
+	obj.ExpiresAt = now.Add(ttl)
+	return true
+}
+
+// GetExpiredObjects возвращает объекты, время жизни которых истекло к моменту now
+func (m *Manager) GetExpiredObjects(now time.Time) []*WorldObject {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var expired []*WorldObject
+	for _, obj := range m.worldObjects {
+		if obj.Expired(now) {
+			expired = append(expired, obj)
+		}
+	}
+	return expired
+}
@@ -6,6 +6,7 @@ import "sync"
 type Manager struct {
 	objects      map[string]*Object
 	worldObjects map[string]*WorldObject
+	byKind       map[ObjectKind]map[string]*WorldObject // Индекс объектов по роли
 	mu           sync.RWMutex
 	factory      *Factory // Фабрика для работы с объектами
 }
@@ -15,6 +16,7 @@ func NewManager() *Manager {
 	return &Manager{
 		objects:      make(map[string]*Object),
 		worldObjects: make(map[string]*WorldObject),
+		byKind:       make(map[ObjectKind]map[string]*WorldObject),
 	}
 }
 
@@ -27,12 +29,61 @@ func (m *Manager) AddObject(obj *Object) {
 	m.AddWorldObject(worldObj)
 }
 
-// AddWorldObject добавляет WorldObject в менеджер
+// AddWorldObject добавляет WorldObject в менеджер.
+// Объекту без роли назначается роль по форме: террейн или декорация.
 func (m *Manager) AddWorldObject(obj *WorldObject) {
+	if obj.Kind == "" {
+		obj.Kind = defaultKind(obj)
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	if old, exists := m.worldObjects[obj.ID]; exists {
+		m.unindexLocked(old)
+	}
 	m.objects[obj.ID] = obj.Object
 	m.worldObjects[obj.ID] = obj
+
+	index, ok := m.byKind[obj.Kind]
+	if !ok {
+		index = make(map[string]*WorldObject)
+		m.byKind[obj.Kind] = index
+	}
+	index[obj.ID] = obj
+}
+
+// GetByKind возвращает все объекты с указанной ролью
+func (m *Manager) GetByKind(kind ObjectKind) []*WorldObject {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	objects := make([]*WorldObject, 0, len(m.byKind[kind]))
+	for _, obj := range m.byKind[kind] {
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// CountByKind возвращает количество объектов с указанной ролью
+func (m *Manager) CountByKind(kind ObjectKind) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.byKind[kind])
+}
+
+// unindexLocked удаляет объект из индекса. Роль объекта могла измениться
+// после добавления, поэтому проверяются все роли (их немного).
+func (m *Manager) unindexLocked(obj *WorldObject) {
+	for _, index := range m.byKind {
+		delete(index, obj.ID)
+	}
+}
+
+func defaultKind(obj *WorldObject) ObjectKind {
+	if obj.Shape != nil && obj.Shape.Type == TERRAIN {
+		return KindTerrain
+	}
+	return KindProp
 }
 
 // GetObject возвращает базовый объект по ID
@@ -77,6 +128,9 @@ func (m *Manager) GetAllWorldObjects() []*WorldObject {
 func (m *Manager) RemoveObject(id string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	if obj, exists := m.worldObjects[id]; exists {
+		m.unindexLocked(obj)
+	}
 	delete(m.objects, id)
 	delete(m.worldObjects, id)
 }
@@ -87,6 +141,7 @@ func (m *Manager) Clear() {
 	defer m.mu.Unlock()
 	m.objects = make(map[string]*Object)
 	m.worldObjects = make(map[string]*WorldObject)
+	m.byKind = make(map[ObjectKind]map[string]*WorldObject)
 }
 
 // UpdateObjectPosition обновляет позицию объекта
@@ -120,3 +175,96 @@ func (m *Manager) GetFactory() *Factory {
 	defer m.mu.RUnlock()
 	return m.factory
 }
+
+// GetTerrain возвращает данные первого террейна в мире (nil, если террейна нет)
+func (m *Manager) GetTerrain() *TerrainData {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if obj := m.terrainObjectLocked(); obj != nil {
+		return obj.Shape.Terrain
+	}
+	return nil
+}
+
+// GroundHeightAt возвращает высоту поверхности земли в точке (x, z).
+// Второе значение false, если террейна нет или точка за его пределами.
+func (m *Manager) GroundHeightAt(x, z float32) (float32, bool) {
+	// Блокировка держится на время выборки, чтобы не читать высоты во время DeformTerrain
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	obj := m.terrainObjectLocked()
+	if obj == nil {
+		return 0, false
+	}
+	return obj.Shape.Terrain.HeightAt(x, z)
+}
+
+// DeformTerrain применяет кисть к террейну мира и возвращает измененный участок.
+// Второе значение false, если террейна нет или кисть его не задела.
+func (m *Manager) DeformTerrain(brush TerrainBrush) (TerrainPatch, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	obj := m.terrainObjectLocked()
+	if obj == nil {
+		return TerrainPatch{}, false
+	}
+
+	terrain := obj.Shape.Terrain
+	region, ok := terrain.ApplyBrush(brush)
+	if !ok {
+		return TerrainPatch{}, false
+	}
+
+	return TerrainPatch{
+		TerrainID:     obj.ID,
+		TerrainRegion: region,
+		Heights:       terrain.Region(region),
+	}, true
+}
+
+// TerrainChunk извлекает чанк террейна terrainID. Высоты читаются под блокировкой,
+// потому что DeformTerrain меняет HeightData на месте.
+func (m *Manager) TerrainChunk(terrainID string, chunkX, chunkZ, chunkSize int32, lod int) (*TerrainChunk, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	obj, ok := m.worldObjects[terrainID]
+	if !ok || obj.Shape == nil || obj.Shape.Terrain == nil {
+		return nil, false
+	}
+	return obj.Shape.Terrain.Chunk(chunkX, chunkZ, chunkSize, lod)
+}
+
+// CopyTerrainObject возвращает копию объекта террейна со своим срезом высот, снятую
+// под блокировкой: копию можно сериализовать, пока DeformTerrain меняет оригинал.
+// Объекты без террейна возвращаются как есть.
+func (m *Manager) CopyTerrainObject(obj *WorldObject) *WorldObject {
+	if obj == nil || obj.Object == nil || obj.Shape == nil || obj.Shape.Terrain == nil {
+		return obj
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	terrain := *obj.Shape.Terrain
+	terrain.HeightData = append([]float32(nil), obj.Shape.Terrain.HeightData...)
+	shape := *obj.Shape
+	shape.Terrain = &terrain
+	object := *obj.Object
+	object.Shape = &shape
+
+	copied := *obj
+	copied.Object = &object
+	return &copied
+}
+
+func (m *Manager) terrainObjectLocked() *WorldObject {
+	for _, obj := range m.worldObjects {
+		if obj.Shape != nil && obj.Shape.Type == TERRAIN && obj.Shape.Terrain != nil {
+			return obj
+		}
+	}
+	return nil
+}
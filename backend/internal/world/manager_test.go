@@ -0,0 +1,92 @@
+package world
+
+import "testing"
+
+func TestManager_GetByKind(t *testing.T) {
+	manager := NewManager()
+
+	player := NewPlayerWithBounceSkill("player_1", Vector3{}, 1, 1, "#ff0000", PhysicsTypeBoth, 0)
+	rock := NewSphere("rock", Vector3{}, 1, 1, "#888888", PhysicsTypeBullet)
+	terrain := NewTerrain("terrain_1", Vector3{}, []float32{0, 0, 0, 0}, 2, 2, 1, 1, 1, 0, 0)
+	legacy := &WorldObject{Object: &Object{ID: "legacy", Shape: &ShapeDescriptor{Type: BOX}}}
+
+	for _, obj := range []*WorldObject{player, rock, terrain, legacy} {
+		manager.AddWorldObject(obj)
+	}
+
+	players := manager.GetByKind(KindPlayer)
+	if len(players) != 1 || players[0].ID != "player_1" || players[0].OwnerID != "player_1" {
+		t.Fatalf("Ожидали одного игрока-владельца player_1, получили %+v", players)
+	}
+	if manager.CountByKind(KindTerrain) != 1 {
+		t.Error("Террейн должен индексироваться по роли")
+	}
+	// Объект без роли считается декорацией
+	if manager.CountByKind(KindProp) != 2 || legacy.Kind != KindProp {
+		t.Errorf("Ожидали 2 декорации, получили %d", manager.CountByKind(KindProp))
+	}
+
+	// Смена роли при повторном добавлении обновляет индекс
+	rock.Kind = KindProjectile
+	manager.AddWorldObject(rock)
+	if manager.CountByKind(KindProp) != 1 || manager.CountByKind(KindProjectile) != 1 {
+		t.Error("Индекс должен обновиться при повторном добавлении объекта")
+	}
+
+	manager.RemoveObject("player_1")
+	if manager.CountByKind(KindPlayer) != 0 {
+		t.Error("Удаленный объект должен пропасть из индекса")
+	}
+}
+
+func TestManager_DeformTerrain(t *testing.T) {
+	manager := NewManager()
+	if _, ok := manager.DeformTerrain(TerrainBrush{Radius: 1, Delta: 1}); ok {
+		t.Error("Без террейна изменять нечего")
+	}
+
+	flat := createFlatTerrain(5)
+	manager.AddWorldObject(NewTerrain("terrain", Vector3{}, flat.HeightData, 5, 5, 1, 2, 1, -10, 10))
+
+	patch, ok := manager.DeformTerrain(TerrainBrush{X: 0, Z: 0, Radius: 1.5, Delta: 6, Falloff: FalloffFlat})
+	if !ok {
+		t.Fatal("Кисть в центре должна изменить террейн")
+	}
+	if patch.TerrainID != "terrain" || len(patch.Heights) != int(patch.Width*patch.Depth) {
+		t.Errorf("Неверный участок: %+v", patch)
+	}
+
+	// Высота земли в мире учитывает изменение: +6 относительно плоского террейна
+	height, ok := manager.GroundHeightAt(0, 0)
+	if !ok || !almostEqual(height, 6) {
+		t.Errorf("Ожидали высоту земли 6, получили %.2f", height)
+	}
+}
+
+func TestManager_CopyTerrainObjectIsolatesHeights(t *testing.T) {
+	manager := NewManager()
+	terrain := NewTerrain("terrain", Vector3{}, make([]float32, 25), 5, 5, 1, 1, 1, -10, 10)
+	manager.AddWorldObject(terrain)
+
+	copied := manager.CopyTerrainObject(terrain)
+	if _, ok := manager.DeformTerrain(TerrainBrush{X: 0, Z: 0, Radius: 2, Delta: -3}); !ok {
+		t.Fatal("Кисть в центре должна изменить террейн")
+	}
+
+	for i, height := range copied.Shape.Terrain.HeightData {
+		if height != 0 {
+			t.Fatalf("Копия не должна видеть деформацию, высота %d = %.2f", i, height)
+		}
+	}
+	if copied.ID != "terrain" || copied.Shape.Terrain.Width != 5 {
+		t.Errorf("Копия должна сохранить описание террейна: %+v", copied.Shape.Terrain)
+	}
+
+	chunk, ok := manager.TerrainChunk("terrain", 0, 0, 4, 0)
+	if !ok || len(chunk.Heights) == 0 {
+		t.Fatal("TerrainChunk должен вернуть чанк существующего террейна")
+	}
+	if _, ok := manager.TerrainChunk("missing", 0, 0, 4, 0); ok {
+		t.Error("Для неизвестного террейна чанка быть не должно")
+	}
+}
@@ -0,0 +1,295 @@
+package world
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Material именованный набор физических свойств поверхности
+type Material struct {
+	Name            string  `json:"name"`
+	Restitution     float32 `json:"restitution"`     // Упругость (отскок)
+	Friction        float32 `json:"friction"`        // Трение
+	RollingFriction float32 `json:"rollingFriction"` // Сопротивление качению
+	LinearDamping   float32 `json:"linearDamping"`   // Линейное затухание
+	AngularDamping  float32 `json:"angularDamping"`  // Угловое затухание
+}
+
+// CombineMode способ объединения свойств двух материалов при контакте
+type CombineMode string
+
+const (
+	CombineMultiply CombineMode = "multiply" // a * b (по умолчанию в Bullet)
+	CombineAverage  CombineMode = "average"  // (a + b) / 2
+	CombineMin      CombineMode = "min"      // меньшее из двух
+	CombineMax      CombineMode = "max"      // большее из двух
+)
+
+// MaterialPairRule правило контакта для конкретной пары материалов.
+// Явные значения Friction/Restitution имеют приоритет над режимами объединения.
+type MaterialPairRule struct {
+	A                  string      `json:"a"`
+	B                  string      `json:"b"`
+	FrictionCombine    CombineMode `json:"frictionCombine,omitempty"`
+	RestitutionCombine CombineMode `json:"restitutionCombine,omitempty"`
+	Friction           *float32    `json:"friction,omitempty"`
+	Restitution        *float32    `json:"restitution,omitempty"`
+}
+
+// MaterialConfig описание библиотеки материалов в файле конфигурации
+type MaterialConfig struct {
+	FrictionCombine    CombineMode        `json:"frictionCombine,omitempty"`
+	RestitutionCombine CombineMode        `json:"restitutionCombine,omitempty"`
+	Materials          []Material         `json:"materials"`
+	Pairs              []MaterialPairRule `json:"pairs"`
+}
+
+// Встроенные материалы; свойства совпадают с прежними NewBouncySphere/NewDeadSphere/NewSlippySphere
+var builtinMaterials = []Material{
+	{Name: "rubber", Restitution: 0.8, Friction: 0.5, RollingFriction: 0.1, LinearDamping: 0.1, AngularDamping: 0.2},
+	{Name: "mud", Restitution: 0.0, Friction: 1.0, RollingFriction: 0.3, LinearDamping: 0.3, AngularDamping: 0.4},
+	{Name: "ice", Restitution: 0.2, Friction: 0.1, RollingFriction: 0.05, LinearDamping: 0.1, AngularDamping: 0.1},
+	{Name: "metal", Restitution: 0.3, Friction: 0.6, RollingFriction: 0.05, LinearDamping: 0.05, AngularDamping: 0.1},
+}
+
+type materialPairKey struct {
+	A, B string
+}
+
+// newMaterialPairKey упорядочивает имена, чтобы пара (a, b) совпадала с (b, a)
+func newMaterialPairKey(a, b string) materialPairKey {
+	if b < a {
+		a, b = b, a
+	}
+	return materialPairKey{A: a, B: b}
+}
+
+// MaterialRegistry библиотека именованных материалов и правил контакта между ними
+type MaterialRegistry struct {
+	mu                 sync.RWMutex
+	materials          map[string]Material
+	pairs              map[materialPairKey]MaterialPairRule
+	frictionCombine    CombineMode
+	restitutionCombine CombineMode
+}
+
+// NewMaterialRegistry создает пустую библиотеку материалов
+func NewMaterialRegistry() *MaterialRegistry {
+	return &MaterialRegistry{
+		materials:          make(map[string]Material),
+		pairs:              make(map[materialPairKey]MaterialPairRule),
+		frictionCombine:    CombineMultiply,
+		restitutionCombine: CombineMultiply,
+	}
+}
+
+// NewDefaultMaterialRegistry создает библиотеку со встроенными материалами (ice, rubber, mud, metal)
+func NewDefaultMaterialRegistry() *MaterialRegistry {
+	registry := NewMaterialRegistry()
+	for _, material := range builtinMaterials {
+		registry.materials[material.Name] = material
+	}
+	return registry
+}
+
+// BuiltinMaterial возвращает встроенный материал по имени
+func BuiltinMaterial(name string) (Material, bool) {
+	for _, material := range builtinMaterials {
+		if material.Name == name {
+			return material, true
+		}
+	}
+	return Material{}, false
+}
+
+// LoadMaterialRegistry загружает библиотеку материалов из JSON-файла.
+// Материалы из файла дополняют и переопределяют встроенные.
+func LoadMaterialRegistry(path string) (*MaterialRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать материалы %s: %w", path, err)
+	}
+
+	var config MaterialConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("ошибка разбора материалов %s: %w", path, err)
+	}
+
+	registry := NewDefaultMaterialRegistry()
+	if err := registry.Apply(config); err != nil {
+		return nil, fmt.Errorf("ошибка в материалах %s: %w", path, err)
+	}
+	return registry, nil
+}
+
+// Apply добавляет в библиотеку материалы и правила из конфигурации
+func (r *MaterialRegistry) Apply(config MaterialConfig) error {
+	for _, mode := range []CombineMode{config.FrictionCombine, config.RestitutionCombine} {
+		if mode != "" && !mode.valid() {
+			return fmt.Errorf("неизвестный режим объединения %q", mode)
+		}
+	}
+
+	for _, material := range config.Materials {
+		if err := r.Register(material); err != nil {
+			return err
+		}
+	}
+	for _, rule := range config.Pairs {
+		if err := r.SetPairRule(rule); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if config.FrictionCombine != "" {
+		r.frictionCombine = config.FrictionCombine
+	}
+	if config.RestitutionCombine != "" {
+		r.restitutionCombine = config.RestitutionCombine
+	}
+	return nil
+}
+
+// Register добавляет или заменяет материал
+func (r *MaterialRegistry) Register(material Material) error {
+	if material.Name == "" {
+		return fmt.Errorf("у материала не указано имя")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.materials[material.Name] = material
+	return nil
+}
+
+// Get возвращает материал по имени
+func (r *MaterialRegistry) Get(name string) (Material, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	material, ok := r.materials[name]
+	return material, ok
+}
+
+// Materials возвращает все материалы, отсортированные по имени
+func (r *MaterialRegistry) Materials() []Material {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	materials := make([]Material, 0, len(r.materials))
+	for _, material := range r.materials {
+		materials = append(materials, material)
+	}
+	sort.Slice(materials, func(i, j int) bool {
+		return materials[i].Name < materials[j].Name
+	})
+	return materials
+}
+
+// SetPairRule задает правило контакта для пары материалов
+func (r *MaterialRegistry) SetPairRule(rule MaterialPairRule) error {
+	for _, mode := range []CombineMode{rule.FrictionCombine, rule.RestitutionCombine} {
+		if mode != "" && !mode.valid() {
+			return fmt.Errorf("неизвестный режим объединения %q для пары %s/%s", mode, rule.A, rule.B)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range []string{rule.A, rule.B} {
+		if _, ok := r.materials[name]; !ok {
+			return fmt.Errorf("правило для пары %s/%s ссылается на неизвестный материал %q", rule.A, rule.B, name)
+		}
+	}
+	r.pairs[newMaterialPairKey(rule.A, rule.B)] = rule
+	return nil
+}
+
+// PairRules возвращает все правила контакта
+func (r *MaterialRegistry) PairRules() []MaterialPairRule {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rules := make([]MaterialPairRule, 0, len(r.pairs))
+	for _, rule := range r.pairs {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		ki, kj := newMaterialPairKey(rules[i].A, rules[i].B), newMaterialPairKey(rules[j].A, rules[j].B)
+		if ki.A != kj.A {
+			return ki.A < kj.A
+		}
+		return ki.B < kj.B
+	})
+	return rules
+}
+
+// CombineModes возвращает режимы объединения по умолчанию (трение, упругость)
+func (r *MaterialRegistry) CombineModes() (CombineMode, CombineMode) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.frictionCombine, r.restitutionCombine
+}
+
+// Combine вычисляет трение и упругость контакта двух материалов.
+// Так же считает bullet-server; функция нужна для проверки правил без Bullet.
+func (r *MaterialRegistry) Combine(a, b string) (friction, restitution float32, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ma, okA := r.materials[a]
+	mb, okB := r.materials[b]
+	if !okA || !okB {
+		return 0, 0, false
+	}
+
+	frictionMode, restitutionMode := r.frictionCombine, r.restitutionCombine
+	rule, hasRule := r.pairs[newMaterialPairKey(a, b)]
+	if hasRule {
+		if rule.FrictionCombine != "" {
+			frictionMode = rule.FrictionCombine
+		}
+		if rule.RestitutionCombine != "" {
+			restitutionMode = rule.RestitutionCombine
+		}
+	}
+
+	friction = frictionMode.combine(ma.Friction, mb.Friction)
+	restitution = restitutionMode.combine(ma.Restitution, mb.Restitution)
+
+	if hasRule && rule.Friction != nil {
+		friction = *rule.Friction
+	}
+	if hasRule && rule.Restitution != nil {
+		restitution = *rule.Restitution
+	}
+	return friction, restitution, true
+}
+
+func (m CombineMode) valid() bool {
+	switch m {
+	case CombineMultiply, CombineAverage, CombineMin, CombineMax:
+		return true
+	}
+	return false
+}
+
+func (m CombineMode) combine(a, b float32) float32 {
+	switch m {
+	case CombineAverage:
+		return (a + b) / 2
+	case CombineMin:
+		return float32(math.Min(float64(a), float64(b)))
+	case CombineMax:
+		return float32(math.Max(float64(a), float64(b)))
+	default:
+		return a * b
+	}
+}
@@ -0,0 +1,98 @@
+package world
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func approxEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-6
+}
+
+func TestMaterialRegistry_CombineDefaults(t *testing.T) {
+	registry := NewDefaultMaterialRegistry()
+
+	friction, restitution, ok := registry.Combine("rubber", "ice")
+	if !ok {
+		t.Fatal("Встроенные материалы должны быть в библиотеке")
+	}
+	if !approxEqual(friction, 0.05) || !approxEqual(restitution, 0.16) {
+		t.Errorf("По умолчанию свойства перемножаются, получили трение %v, упругость %v", friction, restitution)
+	}
+
+	if _, _, ok := registry.Combine("rubber", "glass"); ok {
+		t.Error("Ожидали отказ для неизвестного материала")
+	}
+}
+
+func TestMaterialRegistry_PairRules(t *testing.T) {
+	registry := NewDefaultMaterialRegistry()
+
+	restitution := float32(0)
+	if err := registry.SetPairRule(MaterialPairRule{
+		A:               "ice",
+		B:               "metal",
+		FrictionCombine: CombineMin,
+		Restitution:     &restitution,
+	}); err != nil {
+		t.Fatalf("Ошибка задания правила: %v", err)
+	}
+
+	// Правило не зависит от порядка материалов в паре
+	friction, gotRestitution, _ := registry.Combine("metal", "ice")
+	if !approxEqual(friction, 0.1) || gotRestitution != 0 {
+		t.Errorf("Правило пары не применено: трение %v, упругость %v", friction, gotRestitution)
+	}
+
+	if err := registry.SetPairRule(MaterialPairRule{A: "ice", B: "glass"}); err == nil {
+		t.Error("Ожидали ошибку для правила с неизвестным материалом")
+	}
+	if err := registry.SetPairRule(MaterialPairRule{A: "ice", B: "mud", FrictionCombine: "sum"}); err == nil {
+		t.Error("Ожидали ошибку для неизвестного режима объединения")
+	}
+}
+
+func TestLoadMaterialRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "materials.json")
+	config := `{
+		"frictionCombine": "average",
+		"materials": [
+			{"name": "glass", "restitution": 0.4, "friction": 0.2, "rollingFriction": 0.01},
+			{"name": "ice", "restitution": 0.1, "friction": 0.02}
+		],
+		"pairs": [
+			{"a": "glass", "b": "rubber", "friction": 0.9}
+		]
+	}`
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	registry, err := LoadMaterialRegistry(path)
+	if err != nil {
+		t.Fatalf("Ошибка загрузки материалов: %v", err)
+	}
+
+	if _, ok := registry.Get("mud"); !ok {
+		t.Error("Встроенные материалы должны сохраниться")
+	}
+	if ice, _ := registry.Get("ice"); ice.Friction != 0.02 {
+		t.Errorf("Материал из файла должен переопределить встроенный, трение %v", ice.Friction)
+	}
+
+	if friction, _, _ := registry.Combine("glass", "mud"); !approxEqual(friction, 0.6) {
+		t.Errorf("Ожидали усреднение трения 0.6, получили %v", friction)
+	}
+	if friction, _, _ := registry.Combine("rubber", "glass"); !approxEqual(friction, 0.9) {
+		t.Errorf("Ожидали трение из правила пары 0.9, получили %v", friction)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"pairs": [{"a": "glass", "b": "rubber"}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadMaterialRegistry(path); err == nil {
+		t.Error("Ожидали ошибку для правила с неизвестным материалом")
+	}
+}
@@ -1,6 +1,10 @@
 package world
 
-import "sync"
+import (
+	"errors"
+	"fmt"
+	"math"
+)
 
 // WorldPhysicsConfig содержит глобальные настройки физики мира
 type WorldPhysicsConfig struct {
@@ -41,14 +45,10 @@ type PhysicsConfig struct {
 	Control ControlConfig
 }
 
-var (
-	physicsConfig PhysicsConfig
-	configMutex   sync.RWMutex
-)
-
-// Инициализация конфигурации по умолчанию
-func init() {
-	physicsConfig = PhysicsConfig{
+// DefaultPhysicsConfig возвращает конфигурацию физики по умолчанию - нижний слой
+// при разрешении настроек (см. PhysicsSettings)
+func DefaultPhysicsConfig() PhysicsConfig {
+	return PhysicsConfig{
 		World: WorldPhysicsConfig{
 			// Настройки гравитации
 			GravityX: 0.0,
@@ -80,37 +80,77 @@ func init() {
 	}
 }
 
-// GetPhysicsConfig возвращает текущую конфигурацию физики
-func GetPhysicsConfig() PhysicsConfig {
-	configMutex.RLock()
-	defer configMutex.RUnlock()
-	return physicsConfig
+// physicsConfigField описывает один параметр конфигурации: ключ в файле, допустимый диапазон
+// и доступ к полю. Таблица используется для слоев, переменных окружения, проверки и печати.
+type physicsConfigField struct {
+	key          string // "раздел.параметр", как в JSON-файле
+	min, max     float32
+	minExclusive bool // Значение должно быть строго больше min (массы, импульсы)
+	field        func(c *PhysicsConfig) *float32
 }
 
-// SetPhysicsConfig устанавливает новую конфигурацию физики
-func SetPhysicsConfig(config PhysicsConfig) {
-	configMutex.Lock()
-	defer configMutex.Unlock()
-	physicsConfig = config
+var physicsConfigFields = []physicsConfigField{
+	{key: "world.gravityX", min: -100, max: 100, field: func(c *PhysicsConfig) *float32 { return &c.World.GravityX }},
+	{key: "world.gravityY", min: -100, max: 100, field: func(c *PhysicsConfig) *float32 { return &c.World.GravityY }},
+	{key: "world.gravityZ", min: -100, max: 100, field: func(c *PhysicsConfig) *float32 { return &c.World.GravityZ }},
+	{key: "world.linearDamping", min: 0, max: 1, field: func(c *PhysicsConfig) *float32 { return &c.World.LinearDamping }},
+	{key: "world.angularDamping", min: 0, max: 1, field: func(c *PhysicsConfig) *float32 { return &c.World.AngularDamping }},
+	{key: "world.friction", min: 0, max: 10, field: func(c *PhysicsConfig) *float32 { return &c.World.Friction }},
+	{key: "world.rollingFriction", min: 0, max: 10, field: func(c *PhysicsConfig) *float32 { return &c.World.RollingFriction }},
+	{key: "player.playerMass", min: 0, max: 1e6, minExclusive: true, field: func(c *PhysicsConfig) *float32 { return &c.Player.PlayerMass }},
+	{key: "player.restitution", min: 0, max: 1, field: func(c *PhysicsConfig) *float32 { return &c.Player.Restitution }},
+	{key: "control.baseImpulse", min: 0, max: 1e5, minExclusive: true, field: func(c *PhysicsConfig) *float32 { return &c.Control.BaseImpulse }},
+	{key: "control.maxImpulse", min: 0, max: 1e5, minExclusive: true, field: func(c *PhysicsConfig) *float32 { return &c.Control.MaxImpulse }},
+	{key: "control.distanceMultiplier", min: 0, max: 100, minExclusive: true, field: func(c *PhysicsConfig) *float32 { return &c.Control.DistanceMultiplier }},
+	{key: "control.impulseMultiplier", min: 0, max: 100, minExclusive: true, field: func(c *PhysicsConfig) *float32 { return &c.Control.ImpulseMultiplier }},
+}
+
+func findPhysicsConfigField(key string) (physicsConfigField, bool) {
+	for _, f := range physicsConfigFields {
+		if f.key == key {
+			return f, true
+		}
+	}
+	return physicsConfigField{}, false
 }
 
-// GetWorldConfig возвращает только конфигурацию мира
-func GetWorldConfig() WorldPhysicsConfig {
-	configMutex.RLock()
-	defer configMutex.RUnlock()
-	return physicsConfig.World
+// PhysicsConfigKeys возвращает ключи всех параметров конфигурации в порядке печати
+func PhysicsConfigKeys() []string {
+	keys := make([]string, len(physicsConfigFields))
+	for i, f := range physicsConfigFields {
+		keys[i] = f.key
+	}
+	return keys
 }
 
-// GetPlayerConfig возвращает только конфигурацию игрока
-func GetPlayerConfig() PlayerConfig {
-	configMutex.RLock()
-	defer configMutex.RUnlock()
-	return physicsConfig.Player
+// Get возвращает значение параметра по ключу ("control.baseImpulse")
+func (c PhysicsConfig) Get(key string) (float32, bool) {
+	f, ok := findPhysicsConfigField(key)
+	if !ok {
+		return 0, false
+	}
+	return *f.field(&c), true
 }
 
-// GetControlConfig возвращает только конфигурацию управления
-func GetControlConfig() ControlConfig {
-	configMutex.RLock()
-	defer configMutex.RUnlock()
-	return physicsConfig.Control
+// Validate проверяет диапазоны параметров. Возвращает все найденные ошибки сразу.
+func (c PhysicsConfig) Validate() error {
+	var errs []error
+	for _, f := range physicsConfigFields {
+		value := *f.field(&c)
+		switch {
+		case math.IsNaN(float64(value)) || math.IsInf(float64(value), 0):
+			errs = append(errs, fmt.Errorf("%s: недопустимое значение %v", f.key, value))
+		case f.minExclusive && value <= f.min:
+			errs = append(errs, fmt.Errorf("%s = %g: значение должно быть больше %g", f.key, value, f.min))
+		case value < f.min || value > f.max:
+			errs = append(errs, fmt.Errorf("%s = %g: значение вне диапазона [%g, %g]", f.key, value, f.min, f.max))
+		}
+	}
+
+	if c.Control.BaseImpulse > c.Control.MaxImpulse {
+		errs = append(errs, fmt.Errorf("control.baseImpulse = %g больше control.maxImpulse = %g",
+			c.Control.BaseImpulse, c.Control.MaxImpulse))
+	}
+
+	return errors.Join(errs...)
 }
@@ -0,0 +1,322 @@
+package world
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// PhysicsEnvPrefix префикс переменных окружения с параметрами физики:
+// control.baseImpulse задается переменной XCELLS_PHYSICS_CONTROL_BASE_IMPULSE
+const PhysicsEnvPrefix = "XCELLS_PHYSICS_"
+
+// Имена встроенных слоев конфигурации
+const (
+	PhysicsLayerDefaults = "defaults"
+	PhysicsLayerEnv      = "env"
+)
+
+// PhysicsConfigLayer слой конфигурации физики: частичный набор параметров,
+// переопределяющий значения нижних слоев
+type PhysicsConfigLayer struct {
+	Name   string
+	Values map[string]float32 // ключ "раздел.параметр" -> значение
+}
+
+// PhysicsConfigValue итоговое значение параметра и слой, из которого оно взято
+type PhysicsConfigValue struct {
+	Key     string
+	Value   float32
+	Default float32
+	Source  string
+}
+
+// PhysicsSettings слоеная конфигурация физики: значения по умолчанию, файл сервера,
+// переменные окружения и переопределения для отдельных комнат.
+// Итоговая конфигурация всегда проходит проверку Validate.
+type PhysicsSettings struct {
+	mu     sync.RWMutex
+	layers []PhysicsConfigLayer
+	rooms  map[string]PhysicsConfigLayer
+}
+
+// NewPhysicsSettings создает настройки из слоев сервера (применяются по порядку поверх значений по умолчанию)
+func NewPhysicsSettings(layers ...PhysicsConfigLayer) (*PhysicsSettings, error) {
+	for _, layer := range layers {
+		if err := layer.check(); err != nil {
+			return nil, err
+		}
+	}
+
+	settings := &PhysicsSettings{
+		layers: layers,
+		rooms:  make(map[string]PhysicsConfigLayer),
+	}
+	if err := settings.Resolve("").Validate(); err != nil {
+		return nil, fmt.Errorf("неверная конфигурация физики: %w", err)
+	}
+	return settings, nil
+}
+
+// LoadPhysicsSettings загружает настройки: значения по умолчанию, затем файл сервера (если path
+// не пустой) с разделом "rooms", затем переменные окружения XCELLS_PHYSICS_*
+func LoadPhysicsSettings(path string, lookupEnv func(string) (string, bool)) (*PhysicsSettings, error) {
+	var layers []PhysicsConfigLayer
+	var rooms map[string]PhysicsConfigLayer
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("не удалось прочитать конфигурацию физики %s: %w", path, err)
+		}
+
+		var fileLayer PhysicsConfigLayer
+		fileLayer, rooms, err = ParsePhysicsConfigFile(path, data)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, fileLayer)
+	}
+
+	if lookupEnv != nil {
+		envLayer, err := PhysicsConfigLayerFromEnv(lookupEnv)
+		if err != nil {
+			return nil, err
+		}
+		if len(envLayer.Values) > 0 {
+			layers = append(layers, envLayer)
+		}
+	}
+
+	settings, err := NewPhysicsSettings(layers...)
+	if err != nil {
+		return nil, err
+	}
+
+	for room, layer := range rooms {
+		if err := settings.SetRoomOverrides(room, layer); err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+// ParsePhysicsConfigFile разбирает JSON-файл конфигурации физики:
+//
+//	{
+//	  "control": {"baseImpulse": 100},
+//	  "rooms": {"ice_arena": {"world": {"friction": 0.1}}}
+//	}
+//
+// Возвращает слой сервера и слои комнат. Неизвестные параметры считаются ошибкой.
+func ParsePhysicsConfigFile(name string, data []byte) (PhysicsConfigLayer, map[string]PhysicsConfigLayer, error) {
+	var file map[string]json.RawMessage
+	if err := json.Unmarshal(data, &file); err != nil {
+		return PhysicsConfigLayer{}, nil, fmt.Errorf("ошибка разбора конфигурации физики %s: %w", name, err)
+	}
+
+	rooms := make(map[string]PhysicsConfigLayer)
+	if raw, ok := file["rooms"]; ok {
+		delete(file, "rooms")
+
+		var roomSections map[string]map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &roomSections); err != nil {
+			return PhysicsConfigLayer{}, nil, fmt.Errorf("ошибка разбора комнат в %s: %w", name, err)
+		}
+		for room, sections := range roomSections {
+			layer, err := parsePhysicsConfigSections(name+"#"+room, sections)
+			if err != nil {
+				return PhysicsConfigLayer{}, nil, err
+			}
+			rooms[room] = layer
+		}
+	}
+
+	layer, err := parsePhysicsConfigSections(name, file)
+	if err != nil {
+		return PhysicsConfigLayer{}, nil, err
+	}
+	return layer, rooms, nil
+}
+
+func parsePhysicsConfigSections(name string, sections map[string]json.RawMessage) (PhysicsConfigLayer, error) {
+	layer := PhysicsConfigLayer{Name: name, Values: make(map[string]float32)}
+
+	for section, raw := range sections {
+		var values map[string]float32
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return layer, fmt.Errorf("%s: ошибка разбора раздела %q: %w", name, section, err)
+		}
+		for param, value := range values {
+			layer.Values[section+"."+param] = value
+		}
+	}
+
+	return layer, layer.check()
+}
+
+// PhysicsConfigLayerFromEnv собирает слой из переменных окружения XCELLS_PHYSICS_*
+func PhysicsConfigLayerFromEnv(lookupEnv func(string) (string, bool)) (PhysicsConfigLayer, error) {
+	layer := PhysicsConfigLayer{Name: PhysicsLayerEnv, Values: make(map[string]float32)}
+
+	for _, f := range physicsConfigFields {
+		name := PhysicsEnvName(f.key)
+		raw, ok := lookupEnv(name)
+		if !ok || raw == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(raw), 32)
+		if err != nil {
+			return layer, fmt.Errorf("%s: неверное число %q", name, raw)
+		}
+		layer.Values[f.key] = float32(value)
+	}
+
+	return layer, nil
+}
+
+// PhysicsEnvName возвращает имя переменной окружения для ключа параметра
+func PhysicsEnvName(key string) string {
+	var b strings.Builder
+	b.WriteString(PhysicsEnvPrefix)
+	for _, r := range key {
+		switch {
+		case r == '.':
+			b.WriteByte('_')
+		case unicode.IsUpper(r):
+			b.WriteByte('_')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// check проверяет, что все ключи слоя известны
+func (l PhysicsConfigLayer) check() error {
+	for key := range l.Values {
+		if _, ok := findPhysicsConfigField(key); !ok {
+			return fmt.Errorf("%s: неизвестный параметр физики %q", l.Name, key)
+		}
+	}
+	return nil
+}
+
+// SetRoomOverrides задает переопределения для комнаты. Итоговая конфигурация комнаты
+// проверяется целиком; при ошибке прежние переопределения сохраняются.
+func (s *PhysicsSettings) SetRoomOverrides(room string, layer PhysicsConfigLayer) error {
+	if room == "" {
+		return fmt.Errorf("не указано имя комнаты")
+	}
+	if layer.Name == "" {
+		layer.Name = "room:" + room
+	}
+	if err := layer.check(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	config := s.resolveLocked(nil)
+	applyPhysicsConfigLayer(&config, layer)
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("неверная конфигурация физики комнаты %q: %w", room, err)
+	}
+
+	s.rooms[room] = layer
+	return nil
+}
+
+// Rooms возвращает имена комнат с переопределениями
+func (s *PhysicsSettings) Rooms() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rooms := make([]string, 0, len(s.rooms))
+	for room := range s.rooms {
+		rooms = append(rooms, room)
+	}
+	sort.Strings(rooms)
+	return rooms
+}
+
+// Resolve возвращает итоговую конфигурацию комнаты. Для пустого имени или комнаты
+// без переопределений возвращается конфигурация сервера.
+func (s *PhysicsSettings) Resolve(room string) PhysicsConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var roomLayer *PhysicsConfigLayer
+	if layer, ok := s.rooms[room]; ok {
+		roomLayer = &layer
+	}
+	return s.resolveLocked(roomLayer)
+}
+
+// Explain возвращает итоговые значения параметров комнаты с указанием слоя-источника
+func (s *PhysicsSettings) Explain(room string) []PhysicsConfigValue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	layers := s.layers
+	if layer, ok := s.rooms[room]; ok {
+		layers = append(append([]PhysicsConfigLayer(nil), layers...), layer)
+	}
+
+	defaults := DefaultPhysicsConfig()
+	values := make([]PhysicsConfigValue, 0, len(physicsConfigFields))
+	for _, f := range physicsConfigFields {
+		value := PhysicsConfigValue{
+			Key:     f.key,
+			Value:   *f.field(&defaults),
+			Default: *f.field(&defaults),
+			Source:  PhysicsLayerDefaults,
+		}
+		for _, layer := range layers {
+			if v, ok := layer.Values[f.key]; ok {
+				value.Value = v
+				value.Source = layer.Name
+			}
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+func (s *PhysicsSettings) resolveLocked(roomLayer *PhysicsConfigLayer) PhysicsConfig {
+	config := DefaultPhysicsConfig()
+	for _, layer := range s.layers {
+		applyPhysicsConfigLayer(&config, layer)
+	}
+	if roomLayer != nil {
+		applyPhysicsConfigLayer(&config, *roomLayer)
+	}
+	return config
+}
+
+func applyPhysicsConfigLayer(config *PhysicsConfig, layer PhysicsConfigLayer) {
+	for key, value := range layer.Values {
+		if f, ok := findPhysicsConfigField(key); ok {
+			*f.field(config) = value
+		}
+	}
+}
+
+// DiffPhysicsConfig возвращает ключи параметров, значения которых в a и b различаются
+func DiffPhysicsConfig(a, b PhysicsConfig) []string {
+	var keys []string
+	for _, f := range physicsConfigFields {
+		if *f.field(&a) != *f.field(&b) {
+			keys = append(keys, f.key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,117 @@
+package world
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPhysicsConfig_Validate(t *testing.T) {
+	if err := DefaultPhysicsConfig().Validate(); err != nil {
+		t.Fatalf("Конфигурация по умолчанию должна быть корректной: %v", err)
+	}
+
+	config := DefaultPhysicsConfig()
+	config.Player.PlayerMass = 0
+	config.World.LinearDamping = 1.5
+	config.Control.BaseImpulse = 500
+
+	err := config.Validate()
+	if err == nil {
+		t.Fatal("Ожидали ошибку проверки")
+	}
+	for _, key := range []string{"player.playerMass", "world.linearDamping", "control.baseImpulse"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("В ошибке нет параметра %s: %v", key, err)
+		}
+	}
+}
+
+func TestLoadPhysicsSettings_Layers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "physics.json")
+	config := `{
+		"control": {"baseImpulse": 100},
+		"world": {"friction": 0.8},
+		"rooms": {
+			"ice_arena": {"world": {"friction": 0.05}}
+		}
+	}`
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{
+		"XCELLS_PHYSICS_CONTROL_BASE_IMPULSE": "120",
+	}
+	lookupEnv := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	settings, err := LoadPhysicsSettings(path, lookupEnv)
+	if err != nil {
+		t.Fatalf("Ошибка загрузки конфигурации: %v", err)
+	}
+
+	server := settings.Resolve("")
+	if server.Control.BaseImpulse != 120 || server.World.Friction != 0.8 {
+		t.Errorf("Неверная конфигурация сервера: %+v", server)
+	}
+	if server.Player.PlayerMass != DefaultPhysicsConfig().Player.PlayerMass {
+		t.Errorf("Незаданные параметры должны браться из значений по умолчанию")
+	}
+
+	arena := settings.Resolve("ice_arena")
+	if arena.World.Friction != 0.05 || arena.Control.BaseImpulse != 120 {
+		t.Errorf("Неверная конфигурация комнаты: %+v", arena)
+	}
+
+	for _, value := range settings.Explain("ice_arena") {
+		switch value.Key {
+		case "world.friction":
+			if !strings.HasSuffix(value.Source, "#ice_arena") {
+				t.Errorf("Трение должно браться из комнаты, источник %q", value.Source)
+			}
+		case "control.baseImpulse":
+			if value.Source != PhysicsLayerEnv {
+				t.Errorf("Импульс должен браться из окружения, источник %q", value.Source)
+			}
+		}
+	}
+
+	if diff := DiffPhysicsConfig(server, arena); len(diff) != 1 || diff[0] != "world.friction" {
+		t.Errorf("Ожидали отличие только в world.friction, получили %v", diff)
+	}
+}
+
+func TestPhysicsSettings_RejectsInvalidLayers(t *testing.T) {
+	if _, err := NewPhysicsSettings(PhysicsConfigLayer{
+		Name:   "bad",
+		Values: map[string]float32{"player.playerMass": -1},
+	}); err == nil {
+		t.Error("Ожидали ошибку для отрицательной массы")
+	}
+
+	if _, err := NewPhysicsSettings(PhysicsConfigLayer{
+		Name:   "typo",
+		Values: map[string]float32{"control.baseImpuls": 10},
+	}); err == nil {
+		t.Error("Ожидали ошибку для неизвестного параметра")
+	}
+
+	settings, _ := NewPhysicsSettings()
+	err := settings.SetRoomOverrides("broken", PhysicsConfigLayer{
+		Values: map[string]float32{"control.maxImpulse": 10},
+	})
+	if err == nil {
+		t.Error("Ожидали ошибку: maxImpulse меньше baseImpulse")
+	}
+	if len(settings.Rooms()) != 0 {
+		t.Error("Неверные переопределения комнаты не должны сохраняться")
+	}
+
+	if name := PhysicsEnvName("control.baseImpulse"); name != "XCELLS_PHYSICS_CONTROL_BASE_IMPULSE" {
+		t.Errorf("Неверное имя переменной окружения: %s", name)
+	}
+}
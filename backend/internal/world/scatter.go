@@ -0,0 +1,285 @@
+package world
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+)
+
+// ScatterPropType тип расставляемого объекта
+type ScatterPropType string
+
+const (
+	ScatterBox  ScatterPropType = "box"  // Ящик (динамический, если задана масса)
+	ScatterRock ScatterPropType = "rock" // Камень - статичная сфера, частично утопленная в землю
+	ScatterTree ScatterPropType = "tree" // Дерево - статичный ствол и крона
+)
+
+// ScatterRule правило расстановки объектов одного типа
+type ScatterRule struct {
+	Type ScatterPropType `json:"type"`
+
+	// Плотность - объектов на 100x100 метров площади террейна. Count, если задан, имеет приоритет.
+	Density float32 `json:"density,omitempty"`
+	Count   int     `json:"count,omitempty"`
+
+	// Ограничения по рельефу: наклон в градусах и полоса высот (nil - без ограничения)
+	MinSlope  float32  `json:"minSlope,omitempty"`
+	MaxSlope  float32  `json:"maxSlope,omitempty"` // 0 - без ограничения
+	MinHeight *float32 `json:"minHeight,omitempty"`
+	MaxHeight *float32 `json:"maxHeight,omitempty"`
+
+	// Минимальное расстояние до других расставленных объектов
+	MinSpacing float32 `json:"minSpacing,omitempty"`
+
+	// Размер: ребро ящика, радиус камня или высота дерева
+	MinSize float32 `json:"minSize"`
+	MaxSize float32 `json:"maxSize"`
+
+	Mass     float32 `json:"mass,omitempty"`     // Только для ящиков, 0 - статичный
+	Material string  `json:"material,omitempty"` // Имя материала из библиотеки фабрики
+	Color    string  `json:"color,omitempty"`
+}
+
+// ScatterExclusion круглая зона без объектов (например, вокруг точки спавна)
+type ScatterExclusion struct {
+	Name   string  `json:"name,omitempty"`
+	X      float32 `json:"x"`
+	Z      float32 `json:"z"`
+	Radius float32 `json:"radius"`
+}
+
+// ScatterConfig описание расстановки объектов на террейне.
+// Результат полностью определяется Seed и рельефом.
+type ScatterConfig struct {
+	Seed       uint64             `json:"seed"`
+	Rules      []ScatterRule      `json:"rules"`
+	Exclusions []ScatterExclusion `json:"exclusions,omitempty"`
+}
+
+// ScatterProp расставленный объект
+type ScatterProp struct {
+	ID       string
+	Type     ScatterPropType
+	Position Vector3 // Точка на поверхности террейна
+	Size     float32
+	Rule     int // Индекс правила в ScatterConfig.Rules
+}
+
+// Попыток найти место на один объект; ограничивает время генерации на неподходящем рельефе
+const scatterAttemptsPerProp = 30
+
+// Validate проверяет правила расстановки
+func (c *ScatterConfig) Validate() error {
+	for i, rule := range c.Rules {
+		switch rule.Type {
+		case ScatterBox, ScatterRock, ScatterTree:
+		default:
+			return fmt.Errorf("правило %d: неизвестный тип объекта %q", i, rule.Type)
+		}
+		if rule.MinSize <= 0 || rule.MaxSize < rule.MinSize {
+			return fmt.Errorf("правило %d (%s): неверный размер [%g, %g]", i, rule.Type, rule.MinSize, rule.MaxSize)
+		}
+		if rule.Density < 0 || rule.Count < 0 || rule.Mass < 0 {
+			return fmt.Errorf("правило %d (%s): плотность, количество и масса не могут быть отрицательными", i, rule.Type)
+		}
+		if rule.MaxSlope != 0 && rule.MaxSlope < rule.MinSlope {
+			return fmt.Errorf("правило %d (%s): maxSlope меньше minSlope", i, rule.Type)
+		}
+	}
+	return nil
+}
+
+// ScatterProps вычисляет расстановку объектов на террейне. Функция детерминирована:
+// одинаковые конфигурация и террейн всегда дают одинаковый результат.
+func ScatterProps(terrain *TerrainData, config ScatterConfig) ([]ScatterProp, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewPCG(config.Seed, config.Seed^0x9e3779b97f4a7c15))
+	minX, minZ, maxX, maxZ := terrain.Bounds()
+	area := (maxX - minX) * (maxZ - minZ)
+
+	var props []ScatterProp
+	for ruleIndex, rule := range config.Rules {
+		target := rule.Count
+		if target == 0 {
+			target = int(rule.Density * area / 10000)
+		}
+
+		placed := 0
+		for attempt := 0; attempt < target*scatterAttemptsPerProp && placed < target; attempt++ {
+			x := minX + rng.Float32()*(maxX-minX)
+			z := minZ + rng.Float32()*(maxZ-minZ)
+			size := rule.MinSize + rng.Float32()*(rule.MaxSize-rule.MinSize)
+
+			y, ok := rule.fits(terrain, x, z)
+			if !ok || excluded(config.Exclusions, x, z, size) || crowded(props, rule, x, z, size) {
+				continue
+			}
+
+			props = append(props, ScatterProp{
+				ID:       fmt.Sprintf("scatter_%s_%d", rule.Type, len(props)),
+				Type:     rule.Type,
+				Position: Vector3{X: x, Y: y, Z: z},
+				Size:     size,
+				Rule:     ruleIndex,
+			})
+			placed++
+		}
+
+		if placed < target {
+			log.Printf("[Scatter] Правило %d (%s): размещено %d из %d - мало подходящего рельефа",
+				ruleIndex, rule.Type, placed, target)
+		}
+	}
+
+	return props, nil
+}
+
+// fits проверяет наклон и высоту в точке; возвращает высоту поверхности
+func (r *ScatterRule) fits(terrain *TerrainData, x, z float32) (float32, bool) {
+	y, ok := terrain.HeightAt(x, z)
+	if !ok {
+		return 0, false
+	}
+	if (r.MinHeight != nil && y < *r.MinHeight) || (r.MaxHeight != nil && y > *r.MaxHeight) {
+		return 0, false
+	}
+
+	slope, ok := terrain.SlopeAt(x, z)
+	if !ok {
+		return 0, false
+	}
+	degrees := slope * 180 / math.Pi
+	if degrees < r.MinSlope || (r.MaxSlope > 0 && degrees > r.MaxSlope) {
+		return 0, false
+	}
+	return y, true
+}
+
+func excluded(zones []ScatterExclusion, x, z, size float32) bool {
+	for _, zone := range zones {
+		if distance2D(x, z, zone.X, zone.Z) < zone.Radius+size/2 {
+			return true
+		}
+	}
+	return false
+}
+
+func crowded(props []ScatterProp, rule ScatterRule, x, z, size float32) bool {
+	for _, prop := range props {
+		// Объекты не должны пересекаться, даже если отступ не задан
+		minDistance := (prop.Size + size) / 2
+		if rule.MinSpacing > minDistance {
+			minDistance = rule.MinSpacing
+		}
+		if distance2D(x, z, prop.Position.X, prop.Position.Z) < minDistance {
+			return true
+		}
+	}
+	return false
+}
+
+func distance2D(x1, z1, x2, z2 float32) float32 {
+	dx, dz := x1-x2, z1-z2
+	return float32(math.Sqrt(float64(dx*dx + dz*dz)))
+}
+
+// ScatterObjects создает объекты мира для расстановки. Дерево состоит из двух объектов: ствола и кроны.
+func (f *Factory) ScatterObjects(props []ScatterProp, rules []ScatterRule) ([]*WorldObject, error) {
+	var objects []*WorldObject
+
+	for _, prop := range props {
+		rule := rules[prop.Rule]
+		pos := prop.Position
+
+		switch prop.Type {
+		case ScatterBox:
+			box := f.NewBox(prop.ID, Vector3{X: pos.X, Y: pos.Y + prop.Size/2, Z: pos.Z},
+				prop.Size, prop.Size, prop.Size, rule.Mass, colorOr(rule.Color, "#8B5A2B"), PhysicsTypeBoth)
+			if err := f.applyScatterMaterial(box, rule.Material); err != nil {
+				return nil, err
+			}
+			objects = append(objects, box)
+
+		case ScatterRock:
+			// Камень утоплен в землю на треть радиуса, чтобы не висеть на склоне
+			rock := f.NewSphere(prop.ID, Vector3{X: pos.X, Y: pos.Y + prop.Size*2/3, Z: pos.Z},
+				prop.Size, 0, colorOr(rule.Color, "#7A7A7A"), PhysicsTypeBoth)
+			if err := f.applyScatterMaterial(rock, rule.Material); err != nil {
+				return nil, err
+			}
+			objects = append(objects, rock)
+
+		case ScatterTree:
+			trunkWidth := prop.Size * 0.15
+			trunkHeight := prop.Size * 0.7
+			crownRadius := prop.Size * 0.3
+
+			trunk := f.NewBox(prop.ID+"_trunk", Vector3{X: pos.X, Y: pos.Y + trunkHeight/2, Z: pos.Z},
+				trunkWidth, trunkHeight, trunkWidth, 0, "#5C4033", PhysicsTypeBoth)
+			crown := f.NewSphere(prop.ID+"_crown", Vector3{X: pos.X, Y: pos.Y + trunkHeight + crownRadius*0.8, Z: pos.Z},
+				crownRadius, 0, colorOr(rule.Color, "#2E7D32"), PhysicsTypeBoth)
+			for _, obj := range []*WorldObject{trunk, crown} {
+				if err := f.applyScatterMaterial(obj, rule.Material); err != nil {
+					return nil, err
+				}
+			}
+			objects = append(objects, trunk, crown)
+		}
+	}
+
+	return objects, nil
+}
+
+// Scatter расставляет объекты по правилам на террейне и создает их в Ammo и Bullet
+func (f *Factory) Scatter(terrain *TerrainData, config ScatterConfig) ([]*WorldObject, error) {
+	props, err := ScatterProps(terrain, config)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := f.ScatterObjects(props, config.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objects {
+		// Создаем объект в клиентской физике (Ammo)
+		if err := f.CreateObjectInAmmo(obj); err != nil {
+			log.Printf("[World] Ошибка при создании объекта %s в Ammo: %v", obj.ID, err)
+		}
+
+		// Создаем объект в серверной физике (Bullet)
+		if err := f.CreateObjectBullet(obj); err != nil {
+			log.Printf("[World] Ошибка при создании объекта %s в Bullet: %v", obj.ID, err)
+		}
+	}
+
+	log.Printf("[World] Расставлено объектов: %d (seed %d)", len(objects), config.Seed)
+	return objects, nil
+}
+
+// applyScatterMaterial переносит свойства материала из библиотеки фабрики на объект
+func (f *Factory) applyScatterMaterial(obj *WorldObject, name string) error {
+	if name == "" {
+		return nil
+	}
+	material, ok := f.materials.Get(name)
+	if !ok {
+		return fmt.Errorf("неизвестный материал %q", name)
+	}
+
+	applyMaterial(obj, material)
+	return nil
+}
+
+func colorOr(color, fallback string) string {
+	if color == "" {
+		return fallback
+	}
+	return color
+}
@@ -0,0 +1,133 @@
+package world
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Террейн 64x64: левая половина (x < 0) плоская, правая - крутой склон
+func createScatterTerrain() *TerrainData {
+	const size = 64
+	heights := make([]float32, size*size)
+	for z := 0; z < size; z++ {
+		for x := size / 2; x < size; x++ {
+			heights[z*size+x] = float32(x-size/2) * 4
+		}
+	}
+	return &TerrainData{
+		HeightData: heights,
+		Width:      size,
+		Depth:      size,
+		ScaleX:     2,
+		ScaleY:     1,
+		ScaleZ:     2,
+		MinHeight:  0,
+		MaxHeight:  128,
+	}
+}
+
+func TestScatterProps_RulesAndDeterminism(t *testing.T) {
+	terrain := createScatterTerrain()
+	config := ScatterConfig{
+		Seed: 42,
+		Rules: []ScatterRule{
+			{Type: ScatterRock, Count: 40, MaxSlope: 10, MinSpacing: 6, MinSize: 0.5, MaxSize: 1.5},
+			{Type: ScatterTree, Count: 10, MaxSlope: 10, MinSize: 4, MaxSize: 6},
+		},
+		Exclusions: []ScatterExclusion{{Name: "spawn", X: -30, Z: 0, Radius: 15}},
+	}
+
+	props, err := ScatterProps(terrain, config)
+	if err != nil {
+		t.Fatalf("Ошибка расстановки: %v", err)
+	}
+	if len(props) == 0 {
+		t.Fatal("Ожидали хотя бы один объект")
+	}
+
+	for i, prop := range props {
+		// Крутой склон справа не подходит по наклону
+		if prop.Position.X > 0 {
+			t.Errorf("Объект %s на склоне: %+v", prop.ID, prop.Position)
+		}
+		if distance2D(prop.Position.X, prop.Position.Z, -30, 0) < 15 {
+			t.Errorf("Объект %s в зоне спавна: %+v", prop.ID, prop.Position)
+		}
+		for _, other := range props[:i] {
+			if prop.Type == ScatterRock && other.Type == ScatterRock &&
+				distance2D(prop.Position.X, prop.Position.Z, other.Position.X, other.Position.Z) < 6 {
+				t.Errorf("Камни %s и %s ближе минимального отступа", prop.ID, other.ID)
+			}
+		}
+	}
+
+	again, _ := ScatterProps(terrain, config)
+	if !reflect.DeepEqual(props, again) {
+		t.Error("Одинаковый seed должен давать одинаковую расстановку")
+	}
+
+	config.Seed = 7
+	other, _ := ScatterProps(terrain, config)
+	if reflect.DeepEqual(props, other) {
+		t.Error("Разные seed должны давать разную расстановку")
+	}
+}
+
+func TestScatterProps_HeightBand(t *testing.T) {
+	minHeight := float32(20)
+	props, err := ScatterProps(createScatterTerrain(), ScatterConfig{
+		Seed:  1,
+		Rules: []ScatterRule{{Type: ScatterBox, Count: 10, MinHeight: &minHeight, MinSize: 1, MaxSize: 1}},
+	})
+	if err != nil {
+		t.Fatalf("Ошибка расстановки: %v", err)
+	}
+	if len(props) == 0 {
+		t.Fatal("Ожидали объекты выше 20 метров")
+	}
+	for _, prop := range props {
+		if prop.Position.Y < minHeight {
+			t.Errorf("Объект %s ниже полосы высот: %.2f", prop.ID, prop.Position.Y)
+		}
+	}
+
+	if _, err := ScatterProps(createScatterTerrain(), ScatterConfig{
+		Rules: []ScatterRule{{Type: "lamp", Count: 1, MinSize: 1, MaxSize: 1}},
+	}); err == nil {
+		t.Error("Ожидали ошибку для неизвестного типа объекта")
+	}
+}
+
+func TestFactory_ScatterObjects(t *testing.T) {
+	factory := NewFactory(NewManager(), nil, DefaultPhysicsConfig())
+	rules := []ScatterRule{
+		{Type: ScatterTree, MinSize: 10, MaxSize: 10},
+		{Type: ScatterBox, MinSize: 2, MaxSize: 2, Mass: 5, Material: "metal"},
+	}
+	props := []ScatterProp{
+		{ID: "scatter_tree_0", Type: ScatterTree, Position: Vector3{Y: 3}, Size: 10, Rule: 0},
+		{ID: "scatter_box_1", Type: ScatterBox, Position: Vector3{X: 5, Y: 1}, Size: 2, Rule: 1},
+	}
+
+	objects, err := factory.ScatterObjects(props, rules)
+	if err != nil {
+		t.Fatalf("Ошибка создания объектов: %v", err)
+	}
+	if len(objects) != 3 {
+		t.Fatalf("Дерево - два объекта, ящик - один; получили %d", len(objects))
+	}
+
+	trunk := objects[0]
+	if trunk.Kind != KindProp || trunk.Shape.Box.Mass != 0 || !almostEqual(trunk.Position.Y, 3+3.5) {
+		t.Errorf("Неверный ствол дерева: %+v", trunk.Shape.Box)
+	}
+	box := objects[2]
+	if box.Shape.Box.Material != "metal" || box.Shape.Box.Mass != 5 || !almostEqual(box.Position.Y, 2) {
+		t.Errorf("Неверный ящик: позиция %+v, %+v", box.Position, box.Shape.Box)
+	}
+
+	rules[1].Material = "glass"
+	if _, err := factory.ScatterObjects(props, rules); err == nil {
+		t.Error("Ожидали ошибку для неизвестного материала")
+	}
+}
@@ -0,0 +1,132 @@
+package world
+
+import "math"
+
+// HeightAt возвращает высоту поверхности террейна в мировых координатах (x, z).
+// Высота вычисляется билинейной интерполяцией по четырем соседним узлам сетки
+// с учетом масштаба (ScaleX/ScaleY/ScaleZ) и позиции террейна (Origin).
+// Второе значение false означает, что точка лежит за пределами террейна.
+//
+// Раскладка совпадает с btHeightfieldTerrainShape в bullet-server: сетка
+// центрирована относительно Origin по X/Z, а по вертикали смещена на
+// середину диапазона [MinHeight, MaxHeight].
+func (t *TerrainData) HeightAt(x, z float32) (float32, bool) {
+	gx, gz, ok := t.gridCoords(x, z)
+	if !ok {
+		return 0, false
+	}
+
+	// Индексы ячейки и дробная часть внутри нее
+	x0 := int32(math.Floor(gx))
+	z0 := int32(math.Floor(gz))
+	if x0 >= t.Width-1 {
+		x0 = t.Width - 2
+	}
+	if z0 >= t.Depth-1 {
+		z0 = t.Depth - 2
+	}
+	fx := float32(gx - float64(x0))
+	fz := float32(gz - float64(z0))
+
+	// Билинейная интерполяция
+	h00 := t.sample(x0, z0)
+	h10 := t.sample(x0+1, z0)
+	h01 := t.sample(x0, z0+1)
+	h11 := t.sample(x0+1, z0+1)
+
+	h0 := h00 + (h10-h00)*fx
+	h1 := h01 + (h11-h01)*fx
+	h := h0 + (h1-h0)*fz
+
+	return t.Origin.Y + (h-t.centerHeight())*t.ScaleY, true
+}
+
+// NormalAt возвращает единичную нормаль к поверхности террейна в точке (x, z).
+// Нормаль вычисляется по центральным разностям высот на расстоянии одного шага сетки.
+func (t *TerrainData) NormalAt(x, z float32) (Vector3, bool) {
+	if _, ok := t.HeightAt(x, z); !ok {
+		return Vector3{}, false
+	}
+
+	stepX := t.ScaleX
+	stepZ := t.ScaleZ
+
+	hl := t.clampedHeightAt(x-stepX, z)
+	hr := t.clampedHeightAt(x+stepX, z)
+	hd := t.clampedHeightAt(x, z-stepZ)
+	hu := t.clampedHeightAt(x, z+stepZ)
+
+	// Нормаль = (-dh/dx, 1, -dh/dz), нормализованная
+	nx := float64(hl-hr) / float64(2*stepX)
+	nz := float64(hd-hu) / float64(2*stepZ)
+	length := math.Sqrt(nx*nx + 1 + nz*nz)
+
+	return Vector3{
+		X: float32(nx / length),
+		Y: float32(1 / length),
+		Z: float32(nz / length),
+	}, true
+}
+
+// SlopeAt возвращает угол наклона поверхности в точке (x, z) в радианах
+// (0 - горизонтальная поверхность, π/2 - вертикальная стена)
+func (t *TerrainData) SlopeAt(x, z float32) (float32, bool) {
+	normal, ok := t.NormalAt(x, z)
+	if !ok {
+		return 0, false
+	}
+	return float32(math.Acos(float64(normal.Y))), true
+}
+
+// Bounds возвращает границы террейна в мировых координатах по осям X и Z
+func (t *TerrainData) Bounds() (minX, minZ, maxX, maxZ float32) {
+	halfW := float32(t.Width-1) * t.ScaleX / 2
+	halfD := float32(t.Depth-1) * t.ScaleZ / 2
+	return t.Origin.X - halfW, t.Origin.Z - halfD, t.Origin.X + halfW, t.Origin.Z + halfD
+}
+
+// gridCoords переводит мировые координаты в дробные координаты сетки высот
+func (t *TerrainData) gridCoords(x, z float32) (float64, float64, bool) {
+	if t.Width < 2 || t.Depth < 2 || t.ScaleX == 0 || t.ScaleZ == 0 ||
+		len(t.HeightData) < int(t.Width*t.Depth) {
+		return 0, 0, false
+	}
+
+	gx := float64(x-t.Origin.X)/float64(t.ScaleX) + float64(t.Width-1)/2
+	gz := float64(z-t.Origin.Z)/float64(t.ScaleZ) + float64(t.Depth-1)/2
+
+	if gx < 0 || gz < 0 || gx > float64(t.Width-1) || gz > float64(t.Depth-1) {
+		return 0, 0, false
+	}
+	return gx, gz, true
+}
+
+// clampedHeightAt возвращает высоту, прижимая точку к границам террейна
+func (t *TerrainData) clampedHeightAt(x, z float32) float32 {
+	minX, minZ, maxX, maxZ := t.Bounds()
+	x = float32(math.Max(float64(minX), math.Min(float64(maxX), float64(x))))
+	z = float32(math.Max(float64(minZ), math.Min(float64(maxZ), float64(z))))
+	h, _ := t.HeightAt(x, z)
+	return h
+}
+
+// sample возвращает сырое значение высоты в узле сетки
+func (t *TerrainData) sample(ix, iz int32) float32 {
+	return t.HeightData[iz*t.Width+ix]
+}
+
+// centerHeight возвращает вертикальное смещение, которое Bullet применяет к heightfield
+func (t *TerrainData) centerHeight() float32 {
+	minHeight, maxHeight := t.MinHeight, t.MaxHeight
+
+	// Так же, как bullet-server: при незаданном диапазоне вычисляем его по данным
+	if minHeight == 0 && maxHeight == 0 && len(t.HeightData) > 0 {
+		minHeight, maxHeight = t.HeightData[0], t.HeightData[0]
+		for _, h := range t.HeightData[1:] {
+			minHeight = float32(math.Min(float64(minHeight), float64(h)))
+			maxHeight = float32(math.Max(float64(maxHeight), float64(h)))
+		}
+	}
+
+	return (minHeight + maxHeight) / 2
+}
@@ -0,0 +1,139 @@
+package world
+
+import (
+	"fmt"
+	"math"
+)
+
+// TerrainFalloff профиль кисти: как изменение высоты убывает от центра к краю
+type TerrainFalloff string
+
+const (
+	FalloffSmooth TerrainFalloff = "smooth" // (1 - d²/r²)², плавный край (по умолчанию)
+	FalloffLinear TerrainFalloff = "linear" // 1 - d/r, конус
+	FalloffFlat   TerrainFalloff = "flat"   // Одинаковое изменение по всему кругу
+)
+
+// TerrainBrush круглая кисть для изменения рельефа
+type TerrainBrush struct {
+	X, Z    float32        // Центр в мировых координатах
+	Radius  float32        // Радиус в мировых единицах
+	Delta   float32        // Изменение высоты в центре в мировых единицах (< 0 - воронка, > 0 - холм)
+	Falloff TerrainFalloff // Профиль кисти
+}
+
+// TerrainRegion прямоугольный участок сетки высот
+type TerrainRegion struct {
+	OffsetX, OffsetZ int32 // Индексы первого узла участка
+	Width, Depth     int32 // Количество узлов по X и Z
+}
+
+// TerrainPatch измененный участок террейна с новыми высотами (построчно, Width * Depth)
+type TerrainPatch struct {
+	TerrainID string
+	TerrainRegion
+	Heights []float32
+}
+
+// weight возвращает долю изменения высоты на расстоянии distance от центра кисти
+func (b TerrainBrush) weight(distance float32) float32 {
+	if distance >= b.Radius {
+		return 0
+	}
+	switch b.Falloff {
+	case FalloffFlat:
+		return 1
+	case FalloffLinear:
+		return 1 - distance/b.Radius
+	default:
+		k := 1 - distance*distance/(b.Radius*b.Radius)
+		return k * k
+	}
+}
+
+// ApplyBrush изменяет высоты узлов внутри кисти и возвращает затронутый участок.
+// Второе значение false, если кисть не задела ни одного узла.
+//
+// Высоты ограничиваются диапазоном [MinHeight, MaxHeight]: Bullet и клиент
+// центрируют heightfield по этому диапазону при создании, и его изменение
+// сдвинуло бы весь террейн. Незаданный диапазон фиксируется по текущим данным.
+func (t *TerrainData) ApplyBrush(brush TerrainBrush) (TerrainRegion, bool) {
+	if brush.Radius <= 0 || brush.Delta == 0 || t.ScaleY == 0 {
+		return TerrainRegion{}, false
+	}
+	region, ok := t.brushRegion(brush)
+	if !ok {
+		return TerrainRegion{}, false
+	}
+
+	if t.MinHeight == 0 && t.MaxHeight == 0 {
+		stats := t.Stats()
+		t.MinHeight, t.MaxHeight = stats.MinHeight, stats.MaxHeight
+	}
+
+	minX, minZ, _, _ := t.Bounds()
+	rawDelta := brush.Delta / t.ScaleY
+
+	for iz := region.OffsetZ; iz < region.OffsetZ+region.Depth; iz++ {
+		for ix := region.OffsetX; ix < region.OffsetX+region.Width; ix++ {
+			x := minX + float32(ix)*t.ScaleX
+			z := minZ + float32(iz)*t.ScaleZ
+			w := brush.weight(distance2D(x, z, brush.X, brush.Z))
+			if w == 0 {
+				continue
+			}
+
+			i := iz*t.Width + ix
+			h := t.HeightData[i] + rawDelta*w
+			t.HeightData[i] = float32(math.Max(float64(t.MinHeight), math.Min(float64(t.MaxHeight), float64(h))))
+		}
+	}
+
+	return region, true
+}
+
+// brushRegion возвращает прямоугольник узлов сетки, покрывающий круг кисти
+func (t *TerrainData) brushRegion(brush TerrainBrush) (TerrainRegion, bool) {
+	if t.Width < 2 || t.Depth < 2 || t.ScaleX == 0 || t.ScaleZ == 0 ||
+		len(t.HeightData) < int(t.Width*t.Depth) {
+		return TerrainRegion{}, false
+	}
+
+	minX, minZ, _, _ := t.Bounds()
+	x0 := max(int32(math.Ceil(float64((brush.X-brush.Radius-minX)/t.ScaleX))), 0)
+	x1 := min(int32(math.Floor(float64((brush.X+brush.Radius-minX)/t.ScaleX))), t.Width-1)
+	z0 := max(int32(math.Ceil(float64((brush.Z-brush.Radius-minZ)/t.ScaleZ))), 0)
+	z1 := min(int32(math.Floor(float64((brush.Z+brush.Radius-minZ)/t.ScaleZ))), t.Depth-1)
+	if x0 > x1 || z0 > z1 {
+		return TerrainRegion{}, false
+	}
+
+	return TerrainRegion{OffsetX: x0, OffsetZ: z0, Width: x1 - x0 + 1, Depth: z1 - z0 + 1}, true
+}
+
+// Region возвращает высоты участка сетки построчно
+func (t *TerrainData) Region(region TerrainRegion) []float32 {
+	heights := make([]float32, 0, region.Width*region.Depth)
+	for iz := region.OffsetZ; iz < region.OffsetZ+region.Depth; iz++ {
+		start := iz*t.Width + region.OffsetX
+		heights = append(heights, t.HeightData[start:start+region.Width]...)
+	}
+	return heights
+}
+
+// SetRegion записывает высоты участка сетки (например, при применении TerrainPatch)
+func (t *TerrainData) SetRegion(region TerrainRegion, heights []float32) error {
+	if region.OffsetX < 0 || region.OffsetZ < 0 || region.Width <= 0 || region.Depth <= 0 ||
+		region.OffsetX+region.Width > t.Width || region.OffsetZ+region.Depth > t.Depth {
+		return fmt.Errorf("участок %+v за пределами террейна %dx%d", region, t.Width, t.Depth)
+	}
+	if len(heights) != int(region.Width*region.Depth) {
+		return fmt.Errorf("ожидали %d высот, получили %d", region.Width*region.Depth, len(heights))
+	}
+
+	for k := int32(0); k < region.Depth; k++ {
+		start := (region.OffsetZ+k)*t.Width + region.OffsetX
+		copy(t.HeightData[start:start+region.Width], heights[k*region.Width:(k+1)*region.Width])
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package world
+
+import "math"
+
+// TerrainChunk фрагмент heightmap террейна с определенным уровнем детализации (LOD)
+type TerrainChunk struct {
+	ChunkX, ChunkZ   int32     // Координаты чанка в сетке чанков
+	LOD              int       // Уровень детализации (0 - полная детализация)
+	Step             int32     // Шаг выборки узлов heightmap (1 << LOD)
+	OffsetX, OffsetZ int32     // Индексы первого узла чанка в heightmap
+	Width, Depth     int32     // Количество выборок по X и Z
+	Heights          []float32 // Высоты, построчно (Width * Depth)
+}
+
+// ChunkGrid возвращает количество чанков по X и Z при заданном размере чанка (в ячейках сетки)
+func (t *TerrainData) ChunkGrid(chunkSize int32) (int32, int32) {
+	if chunkSize <= 0 || t.Width < 2 || t.Depth < 2 {
+		return 0, 0
+	}
+	countX := (t.Width - 1 + chunkSize - 1) / chunkSize
+	countZ := (t.Depth - 1 + chunkSize - 1) / chunkSize
+	return countX, countZ
+}
+
+// ChunkCenter возвращает центр чанка в мировых координатах (по X и Z)
+func (t *TerrainData) ChunkCenter(chunkX, chunkZ, chunkSize int32) (float32, float32) {
+	minX, minZ, _, _ := t.Bounds()
+
+	startX := chunkX * chunkSize
+	endX := min(startX+chunkSize, t.Width-1)
+	startZ := chunkZ * chunkSize
+	endZ := min(startZ+chunkSize, t.Depth-1)
+
+	centerX := minX + float32(startX+endX)/2*t.ScaleX
+	centerZ := minZ + float32(startZ+endZ)/2*t.ScaleZ
+	return centerX, centerZ
+}
+
+// Chunk извлекает чанк heightmap с заданным уровнем детализации.
+// Соседние чанки разделяют граничные узлы, поэтому их края совпадают.
+// Узлы берутся с шагом 1<<lod; последняя выборка прижимается к краю чанка,
+// поэтому последний интервал может быть короче шага.
+func (t *TerrainData) Chunk(chunkX, chunkZ, chunkSize int32, lod int) (*TerrainChunk, bool) {
+	countX, countZ := t.ChunkGrid(chunkSize)
+	if chunkX < 0 || chunkZ < 0 || chunkX >= countX || chunkZ >= countZ || lod < 0 {
+		return nil, false
+	}
+
+	step := int32(1) << lod
+	if step > chunkSize {
+		step = chunkSize
+	}
+
+	startX := chunkX * chunkSize
+	endX := min(startX+chunkSize, t.Width-1)
+	startZ := chunkZ * chunkSize
+	endZ := min(startZ+chunkSize, t.Depth-1)
+
+	width := (endX-startX+step-1)/step + 1
+	depth := (endZ-startZ+step-1)/step + 1
+
+	heights := make([]float32, 0, width*depth)
+	for k := int32(0); k < depth; k++ {
+		iz := min(startZ+k*step, endZ)
+		for i := int32(0); i < width; i++ {
+			ix := min(startX+i*step, endX)
+			heights = append(heights, t.sample(ix, iz))
+		}
+	}
+
+	return &TerrainChunk{
+		ChunkX:  chunkX,
+		ChunkZ:  chunkZ,
+		LOD:     lod,
+		Step:    step,
+		OffsetX: startX,
+		OffsetZ: startZ,
+		Width:   width,
+		Depth:   depth,
+		Heights: heights,
+	}, true
+}
+
+// ChunkDistance возвращает расстояние по горизонтали от точки до центра чанка
+func (t *TerrainData) ChunkDistance(x, z float32, chunkX, chunkZ, chunkSize int32) float32 {
+	centerX, centerZ := t.ChunkCenter(chunkX, chunkZ, chunkSize)
+	dx := float64(x - centerX)
+	dz := float64(z - centerZ)
+	return float32(math.Sqrt(dx*dx + dz*dz))
+}
@@ -0,0 +1,244 @@
+package world
+
+import (
+	"math"
+	"testing"
+)
+
+// Создаем террейн 3x3 с известными высотами
+func createTestTerrain(origin Vector3) *TerrainData {
+	return &TerrainData{
+		HeightData: []float32{
+			0, 0, 0,
+			0, 2, 4,
+			0, 4, 8,
+		},
+		Width:     3,
+		Depth:     3,
+		ScaleX:    2.0,
+		ScaleY:    3.0,
+		ScaleZ:    2.0,
+		Origin:    origin,
+		MinHeight: -8,
+		MaxHeight: 8,
+	}
+}
+
+func almostEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
+
+func TestTerrainData_HeightAtGridPoints(t *testing.T) {
+	terrain := createTestTerrain(Vector3{})
+
+	tests := []struct {
+		x, z     float32
+		expected float32
+	}{
+		{-2, -2, 0},  // угол сетки
+		{0, 0, 6},    // центр: 2 * ScaleY
+		{2, 2, 24},   // противоположный угол: 8 * ScaleY
+		{2, 0, 12},   // узел (2, 1): 4 * ScaleY
+		{1, 1, 13.5}, // середина ячейки: (2+4+4+8)/4 * ScaleY
+	}
+
+	for _, tt := range tests {
+		height, ok := terrain.HeightAt(tt.x, tt.z)
+		if !ok {
+			t.Fatalf("Точка (%.1f, %.1f) должна быть внутри террейна", tt.x, tt.z)
+		}
+		if !almostEqual(height, tt.expected) {
+			t.Errorf("Высота в (%.1f, %.1f): ожидали %.2f, получили %.2f", tt.x, tt.z, tt.expected, height)
+		}
+	}
+}
+
+func TestTerrainData_HeightAtRespectsOrigin(t *testing.T) {
+	// Несимметричный диапазон высот смещает heightfield так же, как в Bullet
+	terrain := createTestTerrain(Vector3{X: 100, Y: 10, Z: -50})
+	terrain.MinHeight = 0
+	terrain.MaxHeight = 8
+
+	height, ok := terrain.HeightAt(100, -50)
+	if !ok {
+		t.Fatal("Центр террейна должен быть внутри границ")
+	}
+
+	// 10 + (2 - 4) * 3
+	if !almostEqual(height, 4) {
+		t.Errorf("Ожидали высоту 4, получили %.2f", height)
+	}
+
+	if _, ok := terrain.HeightAt(0, 0); ok {
+		t.Error("Точка (0, 0) лежит за пределами смещенного террейна")
+	}
+}
+
+func TestTerrainData_NormalAndSlope(t *testing.T) {
+	// Плоский террейн: нормаль направлена вверх, наклон нулевой
+	flat := &TerrainData{
+		HeightData: make([]float32, 16),
+		Width:      4,
+		Depth:      4,
+		ScaleX:     1,
+		ScaleY:     1,
+		ScaleZ:     1,
+	}
+
+	normal, ok := flat.NormalAt(0, 0)
+	if !ok {
+		t.Fatal("Точка должна быть внутри террейна")
+	}
+	if !almostEqual(normal.X, 0) || !almostEqual(normal.Y, 1) || !almostEqual(normal.Z, 0) {
+		t.Errorf("Нормаль плоского террейна должна быть (0, 1, 0), получили %+v", normal)
+	}
+
+	// Наклонная плоскость h = x под углом 45 градусов
+	ramp := &TerrainData{
+		HeightData: []float32{
+			0, 1, 2, 3,
+			0, 1, 2, 3,
+			0, 1, 2, 3,
+			0, 1, 2, 3,
+		},
+		Width:     4,
+		Depth:     4,
+		ScaleX:    1,
+		ScaleY:    1,
+		ScaleZ:    1,
+		MinHeight: 0,
+		MaxHeight: 3,
+	}
+
+	slope, ok := ramp.SlopeAt(0, 0)
+	if !ok {
+		t.Fatal("Точка должна быть внутри террейна")
+	}
+	if !almostEqual(slope, math.Pi/4) {
+		t.Errorf("Ожидали наклон π/4, получили %.4f", slope)
+	}
+
+	normal, _ = ramp.NormalAt(0, 0)
+	if normal.X >= 0 {
+		t.Errorf("Нормаль склона, растущего по X, должна смотреть в -X, получили %+v", normal)
+	}
+}
+
+func TestTerrainData_ChunkLOD(t *testing.T) {
+	// Heightmap 9x9, значение узла = его индекс
+	data := make([]float32, 81)
+	for i := range data {
+		data[i] = float32(i)
+	}
+	terrain := &TerrainData{HeightData: data, Width: 9, Depth: 9, ScaleX: 1, ScaleY: 1, ScaleZ: 1}
+
+	chunksX, chunksZ := terrain.ChunkGrid(4)
+	if chunksX != 2 || chunksZ != 2 {
+		t.Fatalf("Ожидали сетку чанков 2x2, получили %dx%d", chunksX, chunksZ)
+	}
+
+	// Полная детализация: 5x5 узлов, соседние чанки разделяют границу
+	chunk, ok := terrain.Chunk(1, 0, 4, 0)
+	if !ok {
+		t.Fatal("Чанк (1, 0) должен существовать")
+	}
+	if chunk.Width != 5 || chunk.Depth != 5 || chunk.OffsetX != 4 || chunk.OffsetZ != 0 {
+		t.Errorf("Неверные размеры чанка: %+v", chunk)
+	}
+	if chunk.Heights[0] != 4 || chunk.Heights[len(chunk.Heights)-1] != 44 {
+		t.Errorf("Неверные граничные высоты чанка: %v", chunk.Heights)
+	}
+
+	// LOD 1: шаг 2, 3x3 узла
+	coarse, _ := terrain.Chunk(1, 1, 4, 1)
+	if coarse.Step != 2 || coarse.Width != 3 || coarse.Depth != 3 {
+		t.Fatalf("Неверные параметры LOD 1: %+v", coarse)
+	}
+	expected := []float32{40, 42, 44, 58, 60, 62, 76, 78, 80}
+	for i, h := range expected {
+		if coarse.Heights[i] != h {
+			t.Errorf("LOD 1, выборка %d: ожидали %.0f, получили %.0f", i, h, coarse.Heights[i])
+		}
+	}
+
+	if _, ok := terrain.Chunk(2, 0, 4, 0); ok {
+		t.Error("Чанк за пределами сетки не должен существовать")
+	}
+}
+
+func createFlatTerrain(size int32) *TerrainData {
+	return &TerrainData{
+		HeightData: make([]float32, size*size),
+		Width:      size,
+		Depth:      size,
+		ScaleX:     1,
+		ScaleY:     2,
+		ScaleZ:     1,
+		MinHeight:  -10,
+		MaxHeight:  10,
+	}
+}
+
+func TestTerrainData_ApplyBrush(t *testing.T) {
+	terrain := createFlatTerrain(5)
+
+	// Плоская кисть радиусом 1.5 в центре задевает блок 3x3; -4 в мире = -2 в единицах heightmap
+	region, ok := terrain.ApplyBrush(TerrainBrush{X: 0, Z: 0, Radius: 1.5, Delta: -4, Falloff: FalloffFlat})
+	if !ok {
+		t.Fatal("Кисть в центре должна изменить террейн")
+	}
+	if region != (TerrainRegion{OffsetX: 1, OffsetZ: 1, Width: 3, Depth: 3}) {
+		t.Errorf("Неверный участок: %+v", region)
+	}
+	for _, h := range terrain.Region(region) {
+		if !almostEqual(h, -2) {
+			t.Errorf("Ожидали высоту -2 внутри кисти, получили %.2f", h)
+		}
+	}
+	if terrain.HeightData[0] != 0 {
+		t.Errorf("Узел вне кисти изменился: %.2f", terrain.HeightData[0])
+	}
+
+	// Высоты не выходят за диапазон [MinHeight, MaxHeight]
+	terrain.ApplyBrush(TerrainBrush{X: 0, Z: 0, Radius: 1.5, Delta: -100, Falloff: FalloffFlat})
+	if h := terrain.HeightData[2*5+2]; h != terrain.MinHeight {
+		t.Errorf("Ожидали высоту, прижатую к минимуму %.0f, получили %.2f", terrain.MinHeight, h)
+	}
+
+	// Плавная кисть: в центре полное изменение, к краю меньше
+	smooth := createFlatTerrain(5)
+	smooth.ApplyBrush(TerrainBrush{X: 0, Z: 0, Radius: 2, Delta: 4})
+	center, edge := smooth.HeightData[2*5+2], smooth.HeightData[2*5+3]
+	if !almostEqual(center, 2) || edge <= 0 || edge >= center {
+		t.Errorf("Неверный профиль плавной кисти: центр %.2f, соседний узел %.2f", center, edge)
+	}
+
+	if _, ok := terrain.ApplyBrush(TerrainBrush{X: 100, Z: 100, Radius: 2, Delta: 1}); ok {
+		t.Error("Кисть за пределами террейна не должна ничего менять")
+	}
+}
+
+func TestTerrainData_SetRegion(t *testing.T) {
+	terrain := createFlatTerrain(4)
+	region := TerrainRegion{OffsetX: 1, OffsetZ: 2, Width: 2, Depth: 2}
+
+	if err := terrain.SetRegion(region, []float32{1, 2, 3, 4}); err != nil {
+		t.Fatalf("Ошибка записи участка: %v", err)
+	}
+	got := terrain.Region(region)
+	for i, h := range []float32{1, 2, 3, 4} {
+		if got[i] != h {
+			t.Errorf("Высота %d: ожидали %.0f, получили %.0f", i, h, got[i])
+		}
+	}
+	if terrain.HeightData[2*4+1] != 1 || terrain.HeightData[3*4+2] != 4 {
+		t.Errorf("Участок записан не туда: %v", terrain.HeightData)
+	}
+
+	if err := terrain.SetRegion(TerrainRegion{OffsetX: 3, OffsetZ: 0, Width: 2, Depth: 1}, []float32{0, 0}); err == nil {
+		t.Error("Ожидали ошибку для участка за пределами террейна")
+	}
+	if err := terrain.SetRegion(region, []float32{1}); err == nil {
+		t.Error("Ожидали ошибку при неверном количестве высот")
+	}
+}
@@ -1,5 +1,7 @@
 package world
 
+import "time"
+
 // PhysicsType определяет, где обрабатывается физика объекта
 type PhysicsType string
 
@@ -9,6 +11,17 @@ const (
 	PhysicsTypeBoth   PhysicsType = "both"   // Физика и на клиенте, и на сервере
 )
 
+// ObjectKind игровая роль объекта мира
+type ObjectKind string
+
+const (
+	KindPlayer     ObjectKind = "player"     // Шар игрока
+	KindFood       ObjectKind = "food"       // Еда
+	KindTerrain    ObjectKind = "terrain"    // Террейн
+	KindProp       ObjectKind = "prop"       // Декорации и прочие физические объекты
+	KindProjectile ObjectKind = "projectile" // Снаряды и выброшенная масса
+)
+
 // WorldObject расширяет базовый Object дополнительными полями для игрового мира
 type WorldObject struct {
 	*Object
@@ -17,6 +30,9 @@ type WorldObject struct {
 	Color       string
 	MinHeight   float32
 	MaxHeight   float32
+	ExpiresAt   time.Time // Момент истечения времени жизни (нулевое значение - объект бессрочный)
+	Kind        ObjectKind
+	OwnerID     string // ID игрока-владельца (для игрока - его собственный ID)
 }
 
 type Object struct {
@@ -61,6 +77,7 @@ type SphereData struct {
 	RollingFriction float32 // Сопротивление качению
 	LinearDamping   float32 // Линейное затухание
 	AngularDamping  float32 // Угловое затухание
+	Material        string  // Имя материала (пустое - свойства заданы напрямую)
 }
 
 type BoxData struct {
@@ -75,6 +92,7 @@ type BoxData struct {
 	RollingFriction float32 // Сопротивление качению
 	LinearDamping   float32 // Линейное затухание
 	AngularDamping  float32 // Угловое затухание
+	Material        string  // Имя материала (пустое - свойства заданы напрямую)
 }
 
 type TerrainData struct {
@@ -84,6 +102,11 @@ type TerrainData struct {
 	ScaleX     float32
 	ScaleY     float32
 	ScaleZ     float32
+	// Размещение в мире (нужно для выборки высот в мировых координатах)
+	Origin    Vector3 // Позиция центра террейна
+	MinHeight float32 // Минимальная высота heightmap
+	MaxHeight float32 // Максимальная высота heightmap
+	Material  string  // Имя материала поверхности
 }
 
 type TreeData struct {
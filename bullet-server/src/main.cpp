@@ -10,6 +10,11 @@
 #include <BulletCollision/CollisionShapes/btHeightfieldTerrainShape.h>
 #include <csignal>  // Для signal()
 #include <iomanip>  // Для std::fixed и std::setprecision
+#include <map>
+#include <mutex>
+#include <string>
+#include <utility>
+#include <algorithm>
 
 using grpc::Server;
 using grpc::ServerBuilder;
@@ -32,6 +37,23 @@ using physics::UpdateObjectRadiusRequest;
 using physics::UpdateObjectRadiusResponse;
 using physics::UpdateObjectMassAndRadiusRequest;
 using physics::UpdateObjectMassAndRadiusResponse;
+using physics::RemoveObjectRequest;
+using physics::RemoveObjectResponse;
+using physics::SetMaterialsRequest;
+using physics::SetMaterialsResponse;
+using physics::SetObjectTransformRequest;
+using physics::SetObjectTransformResponse;
+using physics::UpdateTerrainRegionRequest;
+using physics::UpdateTerrainRegionResponse;
+
+class PhysicsServiceImpl;
+
+// Экземпляр сервиса для глобального callback контактов Bullet
+static PhysicsServiceImpl* g_physicsService = nullptr;
+
+static bool materialContactCallback(btManifoldPoint& cp,
+                                    const btCollisionObjectWrapper* colObj0Wrap, int partId0, int index0,
+                                    const btCollisionObjectWrapper* colObj1Wrap, int partId1, int index1);
 
 class PhysicsServiceImpl final : public Physics::Service {
 public:
@@ -47,6 +69,10 @@ public:
         
         // Устанавливаем гравитацию по оси Y (как в Three.js)
         dynamicsWorld->setGravity(btVector3(0, -9.81f, 0));
+
+        // Правила контакта материалов
+        g_physicsService = this;
+        gContactAddedCallback = materialContactCallback;
         
         // Запускаем поток симуляции
         isRunning = true;
@@ -83,10 +109,12 @@ public:
     Status CreateObject(ServerContext* context, 
                        const CreateObjectRequest* request,
                        CreateObjectResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         std::cout << "[BULLET] Создание объекта: " << request->id() << std::endl;
         
         // Создаем физический объект
         btRigidBody* body = createRigidBody(
+            request->id(),
             request->shape(), 
             request->position(),
             request->rotation()
@@ -99,6 +127,12 @@ public:
 
         // Сохраняем объект
         objects[request->id()] = body;
+
+        // Запоминаем материал для правил контакта
+        std::string material = materialName(request->shape());
+        if (!material.empty()) {
+            assignMaterial(body, material);
+        }
         
         std::cout << "[BULLET] Объект " << request->id() << " создан успешно" << std::endl;
         
@@ -109,6 +143,7 @@ public:
     Status ApplyTorque(ServerContext* context,
                       const ApplyTorqueRequest* request,
                       ApplyTorqueResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         auto it = objects.find(request->id());
         if (it == objects.end()) {
             response->set_status("Объект не найден");
@@ -128,6 +163,7 @@ public:
     Status GetObjectState(ServerContext* context,
                          const GetObjectStateRequest* request,
                          GetObjectStateResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         if (getObjectState(request->id(), response->mutable_state())) {
             response->set_status("OK");
         } else {
@@ -139,6 +175,7 @@ public:
     Status ApplyImpulse(ServerContext* context, 
                         const ApplyImpulseRequest* request,
                         ApplyImpulseResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         auto it = objects.find(request->id());
         if (it == objects.end()) {
             response->set_status("ERROR: Object not found");
@@ -160,6 +197,7 @@ public:
     Status UpdateObjectMass(ServerContext* context, 
                              const UpdateObjectMassRequest* request,
                              UpdateObjectMassResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         auto it = objects.find(request->id());
         if (it == objects.end()) {
             response->set_status("ERROR: Object not found");
@@ -200,6 +238,7 @@ public:
     Status UpdateObjectRadius(ServerContext* context, 
                               const UpdateObjectRadiusRequest* request,
                               UpdateObjectRadiusResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         auto it = objects.find(request->id());
         if (it == objects.end()) {
             response->set_status("ERROR: Object not found");
@@ -265,6 +304,7 @@ public:
     Status UpdateObjectMassAndRadius(ServerContext* context, 
                                      const UpdateObjectMassAndRadiusRequest* request,
                                      UpdateObjectMassAndRadiusResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
         auto it = objects.find(request->id());
         if (it == objects.end()) {
             response->set_status("ERROR: Object not found");
@@ -326,16 +366,277 @@ public:
         return Status::OK;
     }
 
+    // Метод для удаления объекта из физического мира
+    Status RemoveObject(ServerContext* context,
+                        const RemoveObjectRequest* request,
+                        RemoveObjectResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
+        auto it = objects.find(request->id());
+        if (it == objects.end()) {
+            response->set_status("ERROR: Object not found");
+            return Status::OK;
+        }
+
+        btRigidBody* body = it->second;
+        dynamicsWorld->removeRigidBody(body);
+        {
+            std::lock_guard<std::mutex> lock(materialsMutex);
+            bodyMaterials.erase(body);
+        }
+        delete body->getMotionState();
+        delete body->getCollisionShape();
+        delete body;
+        objects.erase(it);
+        terrains.erase(request->id());
+
+        std::cout << "[BULLET] Объект " << request->id() << " удален" << std::endl;
+
+        response->set_status("OK");
+        return Status::OK;
+    }
+
+    // Метод для переноса объекта в новую позицию (телепорт)
+    Status SetObjectTransform(ServerContext* context,
+                              const SetObjectTransformRequest* request,
+                              SetObjectTransformResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
+        auto it = objects.find(request->id());
+        if (it == objects.end()) {
+            response->set_status("ERROR: Object not found");
+            return Status::OK;
+        }
+
+        btRigidBody* body = it->second;
+        const auto& position = request->position();
+
+        btTransform transform = body->getWorldTransform();
+        transform.setOrigin(btVector3(position.x(), position.y(), position.z()));
+        body->setWorldTransform(transform);
+        if (body->getMotionState()) {
+            body->getMotionState()->setWorldTransform(transform);
+        }
+
+        if (request->reset_velocity()) {
+            body->setLinearVelocity(btVector3(0, 0, 0));
+            body->setAngularVelocity(btVector3(0, 0, 0));
+            body->clearForces();
+        }
+        body->activate(true);
+
+        response->set_status("OK");
+        return Status::OK;
+    }
+
+    // Метод для изменения участка heightmap террейна.
+    // btHeightfieldTerrainShape читает высоты напрямую из буфера, поэтому достаточно
+    // обновить буфер и сбросить закэшированные контакты с террейном.
+    Status UpdateTerrainRegion(ServerContext* context,
+                               const UpdateTerrainRegionRequest* request,
+                               UpdateTerrainRegionResponse* response) override {
+        std::lock_guard<std::mutex> lock(worldMutex);
+        auto it = objects.find(request->id());
+        auto terrainIt = terrains.find(request->id());
+        if (it == objects.end() || terrainIt == terrains.end()) {
+            response->set_status("ERROR: Terrain not found");
+            return Status::OK;
+        }
+
+        TerrainStorage& terrain = terrainIt->second;
+        int offsetX = request->offset_x();
+        int offsetZ = request->offset_z();
+        int width = request->width();
+        int depth = request->depth();
+        if (offsetX < 0 || offsetZ < 0 || width <= 0 || depth <= 0 ||
+            offsetX + width > terrain.width || offsetZ + depth > terrain.depth ||
+            request->heights_size() != width * depth) {
+            response->set_status("ERROR: Invalid region");
+            return Status::OK;
+        }
+
+        for (int k = 0; k < depth; k++) {
+            std::copy_n(request->heights().data() + k * width, width,
+                        terrain.heights.begin() + (offsetZ + k) * terrain.width + offsetX);
+        }
+
+        // Сбрасываем контакты, чтобы Bullet пересчитал их по новой поверхности
+        btRigidBody* body = it->second;
+        if (body->getBroadphaseHandle()) {
+            dynamicsWorld->getBroadphase()->getOverlappingPairCache()->cleanProxyFromPairs(
+                body->getBroadphaseHandle(), dispatcher);
+        }
+        for (auto& pair : objects) {
+            if (!pair.second->isStaticObject()) {
+                pair.second->activate(true);
+            }
+        }
+
+        std::cout << "[BULLET] Террейн " << request->id() << ": обновлен участок "
+                  << width << "x" << depth << " с (" << offsetX << ", " << offsetZ << ")" << std::endl;
+
+        response->set_status("OK");
+        return Status::OK;
+    }
+
+    // Метод для установки библиотеки материалов и правил контакта
+    Status SetMaterials(ServerContext* context,
+                        const SetMaterialsRequest* request,
+                        SetMaterialsResponse* response) override {
+        std::lock_guard<std::mutex> lock(materialsMutex);
+
+        materials.clear();
+        for (const auto& m : request->materials()) {
+            materials[m.name()] = MaterialProps{m.restitution(), m.friction(), m.rolling_friction()};
+        }
+
+        materialPairs.clear();
+        for (const auto& p : request->pairs()) {
+            PairRule rule;
+            rule.frictionCombine = p.friction_combine();
+            rule.restitutionCombine = p.restitution_combine();
+            rule.hasFriction = p.has_friction();
+            rule.friction = p.friction();
+            rule.hasRestitution = p.has_restitution();
+            rule.restitution = p.restitution();
+            materialPairs[pairKey(p.a(), p.b())] = rule;
+        }
+
+        defaultFrictionCombine = request->friction_combine().empty() ? "multiply" : request->friction_combine();
+        defaultRestitutionCombine = request->restitution_combine().empty() ? "multiply" : request->restitution_combine();
+
+        std::cout << "[BULLET] Загружено материалов: " << materials.size()
+                  << ", правил контакта: " << materialPairs.size() << std::endl;
+
+        response->set_status("OK");
+        return Status::OK;
+    }
+
+    // Вычисляет трение и упругость контакта по материалам тел.
+    // Возвращает false, если ни у одного тела нет материала.
+    bool combineMaterials(const btCollisionObject* a, const btCollisionObject* b,
+                          float& friction, float& restitution) {
+        std::lock_guard<std::mutex> lock(materialsMutex);
+
+        auto itA = bodyMaterials.find(a);
+        auto itB = bodyMaterials.find(b);
+        if (itA == bodyMaterials.end() && itB == bodyMaterials.end()) {
+            return false;
+        }
+
+        // Тело без материала участвует со своими собственными свойствами
+        MaterialProps propsA{a->getRestitution(), a->getFriction(), a->getRollingFriction()};
+        MaterialProps propsB{b->getRestitution(), b->getFriction(), b->getRollingFriction()};
+        std::string nameA, nameB;
+        if (itA != bodyMaterials.end()) {
+            nameA = itA->second;
+            auto m = materials.find(nameA);
+            if (m != materials.end()) propsA = m->second;
+        }
+        if (itB != bodyMaterials.end()) {
+            nameB = itB->second;
+            auto m = materials.find(nameB);
+            if (m != materials.end()) propsB = m->second;
+        }
+
+        std::string frictionMode = defaultFrictionCombine;
+        std::string restitutionMode = defaultRestitutionCombine;
+        const PairRule* rule = nullptr;
+        auto pairIt = materialPairs.find(pairKey(nameA, nameB));
+        if (pairIt != materialPairs.end()) {
+            rule = &pairIt->second;
+            if (!rule->frictionCombine.empty()) frictionMode = rule->frictionCombine;
+            if (!rule->restitutionCombine.empty()) restitutionMode = rule->restitutionCombine;
+        }
+
+        friction = combineValues(frictionMode, propsA.friction, propsB.friction);
+        restitution = combineValues(restitutionMode, propsA.restitution, propsB.restitution);
+
+        if (rule && rule->hasFriction) friction = rule->friction;
+        if (rule && rule->hasRestitution) restitution = rule->restitution;
+        return true;
+    }
+
 private:
+    // Свойства именованного материала
+    struct MaterialProps {
+        float restitution;
+        float friction;
+        float rollingFriction;
+    };
+
+    // Правило контакта для пары материалов
+    struct PairRule {
+        std::string frictionCombine;
+        std::string restitutionCombine;
+        bool hasFriction = false;
+        float friction = 0.0f;
+        bool hasRestitution = false;
+        float restitution = 0.0f;
+    };
+
+    std::mutex materialsMutex;
+    std::map<std::string, MaterialProps> materials;
+    std::map<std::pair<std::string, std::string>, PairRule> materialPairs;
+    std::map<const btCollisionObject*, std::string> bodyMaterials;
+    std::string defaultFrictionCombine = "multiply";
+    std::string defaultRestitutionCombine = "multiply";
+
+    static std::pair<std::string, std::string> pairKey(const std::string& a, const std::string& b) {
+        return a < b ? std::make_pair(a, b) : std::make_pair(b, a);
+    }
+
+    static float combineValues(const std::string& mode, float a, float b) {
+        if (mode == "average") return (a + b) / 2.0f;
+        if (mode == "min") return std::min(a, b);
+        if (mode == "max") return std::max(a, b);
+        return a * b;
+    }
+
+    static std::string materialName(const ShapeDescriptor& desc) {
+        switch (desc.type()) {
+            case ShapeDescriptor::SPHERE: return desc.sphere().material();
+            case ShapeDescriptor::BOX: return desc.box().material();
+            case ShapeDescriptor::TERRAIN: return desc.terrain().material();
+            default: return "";
+        }
+    }
+
+    // Привязывает материал к телу и включает для него callback контактов
+    void assignMaterial(btRigidBody* body, const std::string& material) {
+        std::lock_guard<std::mutex> lock(materialsMutex);
+        bodyMaterials[body] = material;
+        body->setCollisionFlags(body->getCollisionFlags() |
+                                btCollisionObject::CF_CUSTOM_MATERIAL_CALLBACK);
+
+        // Статическим телам (террейну) свойства берем из материала
+        auto m = materials.find(material);
+        if (m != materials.end() && body->getInvMass() == 0) {
+            body->setFriction(m->second.friction);
+            body->setRestitution(m->second.restitution);
+            body->setRollingFriction(m->second.rollingFriction);
+        }
+    }
+
     btDefaultCollisionConfiguration* collisionConfiguration;
     btCollisionDispatcher* dispatcher;
     btBroadphaseInterface* overlappingPairCache;
     btSequentialImpulseConstraintSolver* solver;
     btDiscreteDynamicsWorld* dynamicsWorld;
     
+    // Защищает dynamicsWorld, objects и terrains: RPC выполняются в потоках gRPC,
+    // а stepSimulation - в потоке симуляции. Порядок блокировок: worldMutex, затем materialsMutex.
+    std::mutex worldMutex;
+
     // Хранилище для созданных объектов
     std::map<std::string, btRigidBody*> objects;
 
+    // Высоты террейнов: btHeightfieldTerrainShape не копирует данные, буфер должен жить вместе с формой
+    struct TerrainStorage {
+        int width = 0;
+        int depth = 0;
+        std::vector<float> heights;
+    };
+    std::map<std::string, TerrainStorage> terrains;
+
     std::thread* simulationThread;
     std::atomic<bool> isRunning;
     const float timeStep = 1.0f/60.0f; // 60 Hz
@@ -344,7 +645,7 @@ private:
     std::chrono::time_point<std::chrono::steady_clock> lastPositionLogTime;
     const std::chrono::milliseconds positionLogInterval{1000}; // Интервал 1 секунда
 
-    btCollisionShape* createTerrainShape(const physics::TerrainData& terrainData) {
+    btCollisionShape* createTerrainShape(const physics::TerrainData& terrainData, const float* heights) {
         int width = terrainData.width();
         int depth = terrainData.depth();
         
@@ -388,7 +689,7 @@ private:
         btHeightfieldTerrainShape* terrainShape = new btHeightfieldTerrainShape(
             width,                          // ширина
             depth,                          // глубина
-            terrainData.heightmap().data(), // данные высот
+            heights,                        // данные высот
             scaleY,                         // масштаб высоты
             minHeight,                      // используем вычисленные или заданные значения
             maxHeight,                      // вместо хардкода
@@ -414,7 +715,8 @@ private:
         return btVector3(v.x(), v.y(), v.z());
     }
 
-    btRigidBody* createRigidBody(const ShapeDescriptor& desc, 
+    btRigidBody* createRigidBody(const std::string& id,
+                                const ShapeDescriptor& desc, 
                                 const physics::Vector3& position,
                                 const physics::Quaternion& rotation) {
         btCollisionShape* shape = nullptr;
@@ -439,7 +741,11 @@ private:
             }
             case ShapeDescriptor::TERRAIN: {
                 const auto& terrainData = desc.terrain();
-                shape = createTerrainShape(terrainData);
+                TerrainStorage& storage = terrains[id];
+                storage.width = terrainData.width();
+                storage.depth = terrainData.depth();
+                storage.heights.assign(terrainData.heightmap().begin(), terrainData.heightmap().end());
+                shape = createTerrainShape(terrainData, storage.heights.data());
                 mass = 0.0f; // Terrain всегда статичен
                 break;
             }
@@ -617,11 +923,15 @@ private:
             auto currentTime = std::chrono::high_resolution_clock::now();
             float deltaTime = std::chrono::duration<float>(currentTime - lastTime).count();
             
-            // Обновляем физику
-            dynamicsWorld->stepSimulation(deltaTime, 10);
-            
-            // Выводим позиции активных объектов
-            logActiveObjectsPositions();
+            {
+                std::lock_guard<std::mutex> lock(worldMutex);
+
+                // Обновляем физику
+                dynamicsWorld->stepSimulation(deltaTime, 10);
+
+                // Выводим позиции активных объектов
+                logActiveObjectsPositions();
+            }
             
             // Ждем, чтобы поддерживать стабильные 60 FPS
             auto frameTime = std::chrono::high_resolution_clock::now() - currentTime;
@@ -642,6 +952,7 @@ private:
 
     // Обновление массы объекта (для тестирования)
     bool testUpdateMass(const std::string& id, float mass) {
+        std::lock_guard<std::mutex> lock(worldMutex);
         auto it = objects.find(id);
         if (it == objects.end()) {
             std::cout << "Объект не найден: " << id << std::endl;
@@ -681,6 +992,26 @@ private:
     }
 };
 
+static bool materialContactCallback(btManifoldPoint& cp,
+                                    const btCollisionObjectWrapper* colObj0Wrap, int partId0, int index0,
+                                    const btCollisionObjectWrapper* colObj1Wrap, int partId1, int index1) {
+    if (!g_physicsService) {
+        return false;
+    }
+
+    float friction = 0.0f;
+    float restitution = 0.0f;
+    if (!g_physicsService->combineMaterials(colObj0Wrap->getCollisionObject(),
+                                            colObj1Wrap->getCollisionObject(),
+                                            friction, restitution)) {
+        return false;
+    }
+
+    cp.m_combinedFriction = friction;
+    cp.m_combinedRestitution = restitution;
+    return true;
+}
+
 void RunServer() {
     std::string server_address("0.0.0.0:50051");
     PhysicsServiceImpl service;
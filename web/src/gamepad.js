@@ -100,8 +100,8 @@ function initGamepad(camera, terrainMesh, playerMesh, socket, scene) {
         requestAnimationFrame(animate);
         
         // Обновляем стрелку если нужно и если она видима
-        if (directionNeedsUpdate && DEBUG_MODE) {
-            updateArrowHelper(playerMesh);
+        if (directionNeedsUpdate && DEBUG_MODE && playerMeshRef) {
+            updateArrowHelper(playerMeshRef);
             directionNeedsUpdate = false;
         }
         
@@ -395,8 +395,13 @@ function updateArrowHelper(playerMesh) {
     }
 }
 
+// Меняет меш игрока после возрождения; null - игрок съеден, команды не отправляются
+function setGamepadPlayerMesh(mesh) {
+    playerMeshRef = mesh;
+}
+
 // Экспортируем функции для использования в других модулях
-export { initGamepad, updateArrowHelper };
+export { initGamepad, updateArrowHelper, setGamepadPlayerMesh };
 
 // Экспортируем функции для получения данных о луче мыши
 export function getCurrentDirection() {
@@ -1,7 +1,7 @@
 // gamestatemanager.js
 
 import { EventEmitter } from 'events';
-import { initGamepad } from './gamepad';
+import { initGamepad, setGamepadPlayerMesh } from './gamepad';
 import { camera } from './camera';
 import {scene} from './scene';
 
@@ -17,6 +17,7 @@ class GameStateManager extends EventEmitter {
         this.playerID = null;      // Динамический ID игрока, получаемый от сервера
         this.playerObjectID = null; // ID объекта игрока в мире
         this.isPlayerIDReceived = false; // Флаг получения ID от сервера
+        this.gameInitialized = false; // Управление уже запущено
     }
 
     setTerrainMesh(mesh) {
@@ -33,12 +34,26 @@ class GameStateManager extends EventEmitter {
 
     checkGameState() {
         if (this.terrainMeshCreated && this.playerMeshCreated) {
+            // После возрождения управление уже запущено - передаем ему новый меш игрока
+            if (this.gameInitialized) {
+                setGamepadPlayerMesh(this.playerMesh);
+                return;
+            }
             initGamepad(camera, this.terrainMesh, this.playerMesh, this.ws, this.scene);
+            this.gameInitialized = true;
             this.emit('gameInitialized');
             console.warn("[Game State Manager] game initialized!!!")
         }
     }
 
+    // Игрока съели: его меш удален со сцены, до возрождения управлять нечем.
+    // Сервер возрождает игрока под тем же ID и присылает player_id и create
+    playerDied() {
+        this.playerMesh = null;
+        this.playerMeshCreated = false;
+        setGamepadPlayerMesh(null);
+    }
+
     // Устанавливает player ID, полученный от сервера
     setPlayerID(playerID, objectID) {
         this.playerID = playerID;
@@ -1,5 +1,5 @@
 // network.js
-import { objects, createMeshAndBodyForObject, updatePlayerSpeedDisplay, updatePhysicsModeDisplay, players, getServerPlayerCount } from './objects';
+import { objects, createMeshAndBodyForObject, removeObject, teleportObject, applyTerrainPatch, applyTerrainChunk, streamedTerrainCreateMessage, updatePlayerSpeedDisplay, updatePhysicsModeDisplay, players, getServerPlayerCount } from './objects';
 import { 
     getPhysicsWorld,
     applyImpulseToSphere,
@@ -301,6 +301,10 @@ function handleMessage(data) {
         if (data.type === "player_id") {
             if (data.player_id && data.object_id) {
                 gameStateManager.setPlayerID(data.player_id, data.object_id);
+                // Запоминаем секретный токен, чтобы после перезапуска сервера вернуть показатели игрока
+                if (data.resume_token) {
+                    localStorage.setItem("xcells_resume_token", data.resume_token);
+                }
                 console.log(`[Network] Получен player ID: ${data.player_id}, object ID: ${data.object_id}`);
                 
                 // Проверяем, есть ли уже созданный объект игрока и устанавливаем playerMesh
@@ -321,6 +325,14 @@ function handleMessage(data) {
             return;
         }
 
+        // Отвечаем на пинг сервера: по возвращенному server_time сервер измеряет задержку
+        if (data.type === "ping") {
+            if (ws && ws.readyState === WebSocket.OPEN) {
+                ws.send(JSON.stringify({ type: "pong", server_time: data.server_time }));
+            }
+            return;
+        }
+
         // Обрабатываем pong-сообщения для синхронизации времени
         if (data.type === "pong") {
             const now = Date.now();
@@ -390,6 +402,13 @@ function handleMessage(data) {
                 receiveObjectUpdate(data);
             }
         } 
+        else if (data.type === "terrain_info" && data.id) {
+            // Террейн чанками: создаем его плоским, высоты придут в terrain_chunk
+            handleMessage(streamedTerrainCreateMessage(data));
+        }
+        else if (data.type === "terrain_chunk" && data.terrain_id) {
+            applyTerrainChunk(data);
+        }
         else if (data.type === "create" && data.id) {
             // Создаем объект и добавляем его в список объектов
             const obj = createMeshAndBodyForObject(data);
@@ -453,6 +472,27 @@ function handleMessage(data) {
                 console.error(`[WS] Не удалось создать объект ${data.id}, тип: ${data.object_type}`);
             }
         } 
+        else if (data.type === "delete" && data.id) {
+            removeObject(data.id);
+        }
+        else if (data.type === "out_of_bounds" && data.object_id) {
+            console.log(`[WS] Игрок ${data.player_id} за границами мира (${data.reason}): ${data.action}`);
+            if (data.action !== "push") {
+                teleportObject(data.object_id, data.x, data.y, data.z);
+            }
+        }
+        else if (data.type === "player_eaten") {
+            console.log(`[WS] Игрок ${data.eater_id} съел игрока ${data.victim_id} (+${data.mass_gain.toFixed(1)} массы)`);
+        }
+        else if (data.type === "player_died") {
+            if (data.player_id === gameStateManager.getPlayerID()) {
+                console.log(`[WS] Вас съел игрок ${data.killer_id}, ожидаем возрождения`);
+                gameStateManager.playerDied();
+            }
+        }
+        else if (data.type === "terrain_patch" && data.terrain_id) {
+            applyTerrainPatch(data);
+        }
         else if (data.type === "cmd_ack") {
             // Обрабатываем подтверждение команды с временной меткой
             
@@ -609,7 +649,18 @@ function handleVisibilityChange() {
 export async function initNetwork() {
     try {
         console.log("[WS] Начало инициализации WebSocket");
-        ws = new WebSocket("ws://localhost:8080/ws");
+        const params = new URLSearchParams();
+        const resumeToken = localStorage.getItem("xcells_resume_token");
+        if (resumeToken) {
+            params.set("resume_token", resumeToken);
+        }
+        // Страница, открытая с ?terrain=chunks, получает террейн чанками вокруг игрока
+        if (new URLSearchParams(window.location.search).get("terrain") === "chunks") {
+            params.set("terrain", "chunks");
+        }
+        const query = params.toString();
+        const wsURL = query ? `ws://localhost:8080/ws?${query}` : "ws://localhost:8080/ws";
+        ws = new WebSocket(wsURL);
         
         ws.onopen = () => {
             console.log("[WS] connected");
@@ -18,6 +18,10 @@ export let objects = {}; // Словарь объектов: id -> { mesh, body,
 export let terrainMesh; // Экспортируем terrainMesh
 export let playerMesh; // Экспортируем playerMesh
 
+// Буферы высот террейнов в памяти Ammo: id -> { ptr, w, h }.
+// btHeightfieldTerrainShape читает высоты из буфера, поэтому изменения рельефа пишутся прямо в него.
+const terrainHeightBuffers = {};
+
 export function createMeshAndBodyForObject(data) {
     if (!data || !data.object_type) {
         console.error("Invalid data received for object creation:", data);
@@ -65,7 +69,10 @@ export function createMeshAndBodyForObject(data) {
             object_type: type, 
             mass: data.mass, // Сохраняем массу из данных сервера
             radius: data.radius, // Сохраняем радиус из данных сервера
-            physicsBy: data.physics_by
+            physicsBy: data.physics_by,
+            heightmap_w: data.heightmap_w, // Для изменения рельефа (terrain_patch, terrain_chunk)
+            heightmap_h: data.heightmap_h,
+            scale_y: data.scale_y
         };
         
         console.log("[Objects] Создан объект:", {
@@ -89,6 +96,150 @@ export function createMeshAndBodyForObject(data) {
     }
 }
 
+// Удаляет объект со сцены и из физического мира (например, по истечении TTL на сервере)
+export function removeObject(id) {
+    const obj = objects[id];
+    if (!obj) {
+        return;
+    }
+
+    if (obj.mesh) {
+        scene.remove(obj.mesh);
+    }
+
+    const physicsWorld = getPhysicsWorld();
+    if (obj.body && physicsWorld) {
+        physicsWorld.removeRigidBody(obj.body);
+    }
+
+    if (terrainHeightBuffers[id]) {
+        Ammo._free(terrainHeightBuffers[id].ptr);
+        delete terrainHeightBuffers[id];
+    }
+
+    delete objects[id];
+    console.log(`[Objects] Удален объект ${id}`);
+}
+
+// Мгновенно переносит объект в новую позицию и сбрасывает его скорость
+// (сервер вернул игрока в границы мира или возродил его)
+export function teleportObject(id, x, y, z) {
+    const obj = objects[id];
+    if (!obj) {
+        return;
+    }
+
+    if (obj.body && window.Ammo) {
+        const transform = new window.Ammo.btTransform();
+        transform.setIdentity();
+        transform.setOrigin(new window.Ammo.btVector3(x, y, z));
+        obj.body.setWorldTransform(transform);
+        obj.body.getMotionState().setWorldTransform(transform);
+
+        const zeroVelocity = new window.Ammo.btVector3(0, 0, 0);
+        obj.body.setLinearVelocity(zeroVelocity);
+        obj.body.setAngularVelocity(zeroVelocity);
+        obj.body.activate();
+
+        window.Ammo.destroy(zeroVelocity);
+        window.Ammo.destroy(transform);
+    }
+
+    if (obj.mesh) {
+        obj.mesh.position.set(x, y, z);
+    }
+}
+
+// Применяет измененный участок heightmap (сообщение terrain_patch) к мешу и физике террейна
+export function applyTerrainPatch(patch) {
+    const obj = objects[patch.terrain_id];
+    if (!obj) {
+        return;
+    }
+
+    const buffer = terrainHeightBuffers[patch.terrain_id];
+    const w = buffer ? buffer.w : obj.heightmap_w;
+    const positions = obj.mesh && obj.mesh.geometry.attributes.position;
+
+    for (let k = 0; k < patch.depth; k++) {
+        for (let i = 0; i < patch.width; i++) {
+            const height = patch.heights[k * patch.width + i];
+            const index = (patch.offset_z + k) * w + patch.offset_x + i;
+
+            if (buffer) {
+                Ammo.HEAPF32[(buffer.ptr >> 2) + index] = height;
+            }
+            if (positions) {
+                positions.array[index * 3 + 1] = height * obj.scale_y;
+            }
+        }
+    }
+
+    if (positions) {
+        positions.needsUpdate = true;
+        obj.mesh.geometry.computeVertexNormals();
+    }
+
+    // Будим тела, лежащие на террейне, чтобы они заметили новую поверхность
+    Object.values(objects).forEach(o => {
+        if (o.body && o.object_type !== "terrain") {
+            o.body.activate();
+        }
+    });
+}
+
+// Превращает terrain_info (террейн, передаваемый чанками) в сообщение create с плоским
+// рельефом: высоты придут в terrain_chunk и будут записаны через applyTerrainChunk
+export function streamedTerrainCreateMessage(info) {
+    return {
+        ...info,
+        type: "create",
+        object_type: "terrain",
+        height_data: new Float32Array(info.heightmap_w * info.heightmap_h)
+    };
+}
+
+// Применяет чанк высот (сообщение terrain_chunk). Чанк с LOD > 0 содержит каждый step-й узел,
+// промежуточные высоты восстанавливаются билинейной интерполяцией.
+export function applyTerrainChunk(chunk) {
+    const obj = objects[chunk.terrain_id];
+    if (!obj) {
+        return;
+    }
+
+    const step = chunk.step || 1;
+    const endX = Math.min(chunk.offset_x + (chunk.width - 1) * step, obj.heightmap_w - 1);
+    const endZ = Math.min(chunk.offset_z + (chunk.depth - 1) * step, obj.heightmap_h - 1);
+    const width = endX - chunk.offset_x + 1;
+    const depth = endZ - chunk.offset_z + 1;
+
+    const sample = (i, k) => chunk.heights[Math.min(k, chunk.depth - 1) * chunk.width + Math.min(i, chunk.width - 1)];
+
+    const heights = new Array(width * depth);
+    for (let z = 0; z < depth; z++) {
+        const fz = z / step;
+        const k = Math.floor(fz);
+        const tz = fz - k;
+        for (let x = 0; x < width; x++) {
+            const fx = x / step;
+            const i = Math.floor(fx);
+            const tx = fx - i;
+            const top = sample(i, k) * (1 - tx) + sample(i + 1, k) * tx;
+            const bottom = sample(i, k + 1) * (1 - tx) + sample(i + 1, k + 1) * tx;
+            heights[z * width + x] = top * (1 - tz) + bottom * tz;
+        }
+    }
+
+    applyTerrainPatch({
+        terrain_id: chunk.terrain_id,
+        offset_x: chunk.offset_x,
+        offset_z: chunk.offset_z,
+        width,
+        depth,
+        heights
+    });
+}
+
 function createPhysicsBodyForTerrain(data) {
     const physicsWorld = getPhysicsWorld();
     if (!physicsWorld) {
@@ -108,6 +259,7 @@ function createPhysicsBodyForTerrain(data) {
 
     // Создаем буфер в памяти Ammo для данных высот
     const ammoHeightData = Ammo._malloc(4 * w * h);
+    terrainHeightBuffers[data.id] = { ptr: ammoHeightData, w, h };
     
     // Копируем данные высот в память Ammo
     let p = 0;